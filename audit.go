@@ -0,0 +1,224 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditRecord describes a single state-changing request issued through a
+// ProviderClient. It is serialized as one line of JSON by the built-in sinks.
+type AuditRecord struct {
+	// Time is the time at which the request was issued.
+	Time time.Time `json:"timestamp"`
+
+	// UserID and ProjectID identify who the request was issued as. They are
+	// empty if the identity of the ProviderClient is unknown.
+	UserID    string `json:"user_id,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+
+	// ServiceType is the type of the service the request was addressed to.
+	// It is empty for requests that were not issued through a ServiceClient.
+	ServiceType string `json:"service_type,omitempty"`
+
+	// Method and URL identify the resource that was acted upon.
+	Method string `json:"method"`
+	URL    string `json:"url"`
+
+	// Body is a summary of the JSON request body with any sensitive values
+	// redacted and long strings truncated. It is empty for raw bodies.
+	Body interface{} `json:"body,omitempty"`
+
+	// StatusCode is the HTTP status code of the final response, or 0 if no
+	// response was received.
+	StatusCode int `json:"status"`
+
+	// RequestID is the request ID returned by the service, if any.
+	RequestID string `json:"request_id,omitempty"`
+
+	// Error is the error returned by the request, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink stores audit records.
+type AuditSink interface {
+	WriteAuditRecord(r *AuditRecord) error
+}
+
+// Auditor records every POST, PUT, PATCH and DELETE request issued through a
+// ProviderClient, once the request has completed.
+type Auditor struct {
+	// Sink receives the audit records.
+	Sink AuditSink
+
+	// Identity, if set, returns the user and project the ProviderClient is
+	// currently authenticated as. It defaults to the user and project of the
+	// ProviderClient's AuthIdentity.
+	Identity func() (userID, projectID string)
+
+	// RedactKeys lists additional body keys whose values must not be
+	// recorded. Keys are matched case-insensitively as substrings, in addition
+	// to DefaultAuditRedactKeys.
+	RedactKeys []string
+
+	// ErrorFunc, if set, is called when a record cannot be written to Sink.
+	ErrorFunc func(err error)
+}
+
+// DefaultAuditRedactKeys lists the body keys whose values are always redacted
+// from audit records.
+var DefaultAuditRedactKeys = []string{
+	"password",
+	"passwd",
+	"passcode",
+	"secret",
+	"signature",
+	"token",
+	"adminpass",
+	"private_key",
+	"user_data",
+	"blob",
+}
+
+// auditMaxStringLength is the length past which string values are truncated in
+// audit records.
+const auditMaxStringLength = 256
+
+// auditRequestIDHeaders lists the response headers services use to return the
+// ID of a request.
+var auditRequestIDHeaders = []string{
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+	"X-Trans-Id",
+}
+
+// audit writes a record for a completed request if it is state-changing.
+// identity is the AuthIdentity of the ProviderClient, which may be nil.
+func (a *Auditor) audit(identity *AuthIdentity, start time.Time, method, url string, options *RequestOpts, resp *http.Response, err error) {
+	switch method {
+	case "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return
+	}
+
+	r := &AuditRecord{
+		Time:        start.UTC(),
+		ServiceType: options.serviceType,
+		Method:      method,
+		URL:         url,
+	}
+	if a.Identity != nil {
+		r.UserID, r.ProjectID = a.Identity()
+	} else if identity != nil {
+		r.UserID, r.ProjectID = identity.UserID, identity.ProjectID
+	}
+	if options.JSONBody != nil {
+		r.Body = a.summarize(options.JSONBody)
+	}
+	if resp != nil {
+		r.StatusCode = resp.StatusCode
+		for _, h := range auditRequestIDHeaders {
+			if id := resp.Header.Get(h); id != "" {
+				r.RequestID = id
+				break
+			}
+		}
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	if werr := a.Sink.WriteAuditRecord(r); werr != nil && a.ErrorFunc != nil {
+		a.ErrorFunc(werr)
+	}
+}
+
+// summarize returns a generic copy of a request body with sensitive values
+// redacted and long strings truncated.
+func (a *Auditor) summarize(body interface{}) interface{} {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	return a.redact(v)
+}
+
+func (a *Auditor) redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if a.isRedacted(k) {
+				v[k] = "***"
+			} else {
+				v[k] = a.redact(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = a.redact(e)
+		}
+	case string:
+		if len(v) > auditMaxStringLength {
+			return v[:auditMaxStringLength] + "..."
+		}
+	}
+	return v
+}
+
+func (a *Auditor) isRedacted(key string) bool {
+	key = strings.ToLower(key)
+	for _, keys := range [][]string{DefaultAuditRedactKeys, a.RedactKeys} {
+		for _, k := range keys {
+			if strings.Contains(key, strings.ToLower(k)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriterAuditSink is an AuditSink that writes each record as a line of JSON
+// to an io.Writer. It is safe for concurrent use.
+type WriterAuditSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	w   io.Writer
+}
+
+// NewWriterAuditSink returns an AuditSink that writes JSON lines to w.
+func NewWriterAuditSink(w io.Writer) *WriterAuditSink {
+	return &WriterAuditSink{enc: json.NewEncoder(w), w: w}
+}
+
+// NewFileAuditSink returns an AuditSink that appends JSON lines to the file at
+// path, creating it if necessary. The file should be released with Close.
+func NewFileAuditSink(path string) (*WriterAuditSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterAuditSink(f), nil
+}
+
+// WriteAuditRecord implements AuditSink.
+func (s *WriterAuditSink) WriteAuditRecord(r *AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(r)
+}
+
+// Close closes the underlying writer if it implements io.Closer.
+func (s *WriterAuditSink) Close() error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
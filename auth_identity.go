@@ -0,0 +1,61 @@
+package gophercloud
+
+import "time"
+
+// AuthIdentity describes who a ProviderClient is authenticated as: the user,
+// the scope and the roles of its token. The authentication functions of the
+// openstack package keep it up to date, so that it can be inspected without
+// another request to the Identity service.
+type AuthIdentity struct {
+	// UserID, UserName and UserDomainID identify the user the token was
+	// issued to.
+	UserID       string
+	UserName     string
+	UserDomainID string
+
+	// ProjectID, ProjectName and ProjectDomainID identify the project of a
+	// project-scoped token.
+	ProjectID       string
+	ProjectName     string
+	ProjectDomainID string
+
+	// DomainID and DomainName identify the domain of a domain-scoped token.
+	DomainID   string
+	DomainName string
+
+	// System is true for system-scoped tokens.
+	System bool
+
+	// Roles lists the roles the token carries on its scope.
+	Roles []AuthRole
+
+	// Methods lists the methods the user authenticated with, e.g. "password"
+	// or "token".
+	Methods []string
+
+	// TrustID is the ID of the trust of a trust-scoped token.
+	TrustID string
+
+	// ApplicationCredentialID is the ID of the application credential the
+	// token was obtained with, if any.
+	ApplicationCredentialID string
+
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt time.Time
+}
+
+// AuthRole is a role carried by a token.
+type AuthRole struct {
+	ID   string
+	Name string
+}
+
+// HasRole reports whether the token carries the role with the given name.
+func (i *AuthIdentity) HasRole(name string) bool {
+	for _, r := range i.Roles {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
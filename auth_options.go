@@ -52,6 +52,53 @@ type AuthOptions struct {
 	// TokenID allows users to authenticate (possibly as another user) with an
 	// authentication token ID.
 	TokenID string
+
+	// ApplicationCredentialID and ApplicationCredentialSecret allow users to
+	// authenticate to Identity V3 with an application credential instead of a
+	// password. The credential may also be identified by
+	// ApplicationCredentialName, together with either UserID or Username and
+	// one of DomainID or DomainName. Tokens issued for application credentials
+	// are always scoped to the project the credential belongs to, so TenantID
+	// and TenantName are ignored.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// TrustID scopes the Identity V3 token to a trust, so that the user
+	// authenticating, the trustee, acts with the roles delegated by the trust.
+	// TenantID and TenantName are ignored when it is provided.
+	TrustID string `json:"-"`
+
+	// Scope determines the scope of the Identity V3 token. If it is nil, the
+	// token is scoped to the project given by TenantID or TenantName, if any.
+	Scope *AuthScope `json:"-"`
+}
+
+// AuthScope describes the scope of an Identity V3 token. Exactly one of the
+// following must be provided: ProjectID, ProjectName with one of DomainID or
+// DomainName, DomainID, System, or Unscoped.
+type AuthScope struct {
+	// ProjectID scopes the token to the project with the given ID.
+	ProjectID string
+
+	// ProjectName scopes the token to the project with the given name, in the
+	// domain given by DomainID or DomainName.
+	ProjectName string
+
+	// DomainID scopes the token to the domain with the given ID, unless a
+	// ProjectName is provided, in which case it identifies the domain of the
+	// project.
+	DomainID string
+
+	// DomainName identifies the domain of the project given by ProjectName.
+	DomainName string
+
+	// System scopes the token to the entire deployment.
+	System bool
+
+	// Unscoped requests a token without any scope, even if the user has a
+	// default project.
+	Unscoped bool
 }
 
 // ToTokenV2CreateMap allows AuthOptions to satisfy the AuthOptionsBuilder
@@ -0,0 +1,155 @@
+package bulk
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// DefaultConcurrency is the number of operations Execute runs at once when
+// Opts.Concurrency is not set.
+const DefaultConcurrency = 10
+
+// Operation is a single unit of work run by Execute. It usually wraps a
+// Gophercloud call and returns its extracted result, if any.
+type Operation func() (interface{}, error)
+
+// Opts configures Execute.
+type Opts struct {
+	// Concurrency is the maximum number of operations running at once. It
+	// defaults to DefaultConcurrency.
+	Concurrency int
+
+	// Rate, if greater than zero, is the maximum number of operations started
+	// per second.
+	Rate float64
+
+	// StopOnError stops Execute from starting new operations once one has
+	// failed. Operations that are already running are waited for.
+	StopOnError bool
+}
+
+// Result is the outcome of a single Operation.
+type Result struct {
+	// Index is the position of the Operation in the slice given to Execute.
+	Index int
+
+	// Value is the value returned by the Operation.
+	Value interface{}
+
+	// Err is the error returned by the Operation.
+	Err error
+
+	// Skipped reports whether the Operation was never started because
+	// Execute stopped early.
+	Skipped bool
+}
+
+// OperationError is the error returned by a single Operation.
+type OperationError struct {
+	Index int
+	Err   error
+}
+
+// ErrOperationsFailed is the error returned by Execute when one or more
+// operations failed. Errors is ordered by operation index.
+type ErrOperationsFailed struct {
+	gophercloud.BaseError
+	Errors []OperationError
+	Total  int
+}
+
+func (e ErrOperationsFailed) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, oe := range e.Errors {
+		msgs[i] = fmt.Sprintf("[%d] %s", oe.Index, oe.Err)
+	}
+	return fmt.Sprintf("%d of %d operations failed: %s", len(e.Errors), e.Total, strings.Join(msgs, "; "))
+}
+
+// Execute runs ops according to opts and returns one Result per Operation, in
+// the order of ops. If any Operation failed, it also returns an
+// *ErrOperationsFailed.
+//
+// Re-authentication is left to the ProviderClient of each Operation. A
+// ProviderClient shared by several operations must use a token lock, as the
+// ones from openstack.NewClient do (see ProviderClient.UseTokenLock), so that
+// operations rejected together with an expired token re-authenticate once. If
+// an Operation fails because the ProviderClient could not re-authenticate,
+// Execute stops starting new operations, as they would fail too.
+func Execute(ops []Operation, opts Opts) ([]Result, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	var interval time.Duration
+	if opts.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / opts.Rate)
+	}
+
+	results := make([]Result, len(ops))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var stopped int32
+	var next time.Time
+
+	for i, op := range ops {
+		results[i].Index = i
+
+		sem <- struct{}{}
+		if atomic.LoadInt32(&stopped) != 0 {
+			<-sem
+			results[i].Skipped = true
+			continue
+		}
+
+		if interval > 0 {
+			now := time.Now()
+			if wait := next.Sub(now); wait > 0 {
+				time.Sleep(wait)
+				now = next
+			}
+			next = now.Add(interval)
+		}
+
+		wg.Add(1)
+		go func(r *Result, op Operation) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r.Value, r.Err = op()
+			if r.Err != nil && (opts.StopOnError || isReauthFailure(r.Err)) {
+				atomic.StoreInt32(&stopped, 1)
+			}
+		}(&results[i], op)
+	}
+	wg.Wait()
+
+	var errs []OperationError
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, OperationError{Index: r.Index, Err: r.Err})
+		}
+	}
+	if len(errs) > 0 {
+		return results, &ErrOperationsFailed{Errors: errs, Total: len(ops)}
+	}
+	return results, nil
+}
+
+// isReauthFailure reports whether err means the ProviderClient was unable to
+// re-authenticate.
+func isReauthFailure(err error) bool {
+	switch err.(type) {
+	case gophercloud.ErrUnableToReauthenticate, *gophercloud.ErrUnableToReauthenticate:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+/*
+Package bulk runs many Gophercloud operations, such as deleting hundreds of
+ports or creating a batch of servers, with bounded concurrency and optional
+rate limiting.
+
+Each operation is a function wrapping a regular Gophercloud call. Operations
+share the ProviderClient of the calls they wrap, so a token that expires half
+way through is renewed by the ProviderClient's usual re-authentication.
+
+Example to Delete Ports
+
+	var ops []bulk.Operation
+	for _, id := range portIDs {
+		id := id
+		ops = append(ops, func() (interface{}, error) {
+			return nil, ports.Delete(networkClient, id).ExtractErr()
+		})
+	}
+
+	results, err := bulk.Execute(ops, bulk.Opts{
+		Concurrency: 10,
+		Rate:        20,
+	})
+	if err != nil {
+		for _, e := range err.(*bulk.ErrOperationsFailed).Errors {
+			fmt.Printf("unable to delete port %s: %s\n", portIDs[e.Index], e.Err)
+		}
+	}
+
+Example to Create Servers
+
+	var ops []bulk.Operation
+	for _, opts := range createOpts {
+		opts := opts
+		ops = append(ops, func() (interface{}, error) {
+			return servers.Create(computeClient, opts).Extract()
+		})
+	}
+
+	results, err := bulk.Execute(ops, bulk.Opts{StopOnError: true})
+	for _, r := range results {
+		if r.Err == nil && !r.Skipped {
+			server := r.Value.(*servers.Server)
+			fmt.Printf("created server %s\n", server.ID)
+		}
+	}
+*/
+package bulk
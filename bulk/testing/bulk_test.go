@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/bulk"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestExecute(t *testing.T) {
+	var running, maxRunning int32
+	var ops []bulk.Operation
+	for i := 0; i < 10; i++ {
+		i := i
+		ops = append(ops, func() (interface{}, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+
+			if i%4 == 3 {
+				return nil, errors.New("failed")
+			}
+			return i * 2, nil
+		})
+	}
+
+	results, err := bulk.Execute(ops, bulk.Opts{Concurrency: 3})
+	th.AssertEquals(t, 10, len(results))
+	th.AssertEquals(t, true, maxRunning <= 3)
+
+	for i, r := range results {
+		th.CheckEquals(t, i, r.Index)
+		th.CheckEquals(t, false, r.Skipped)
+		if i%4 == 3 {
+			th.CheckEquals(t, "failed", r.Err.Error())
+		} else {
+			th.CheckNoErr(t, r.Err)
+			th.CheckEquals(t, i*2, r.Value)
+		}
+	}
+
+	multi, ok := err.(*bulk.ErrOperationsFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 2, len(multi.Errors))
+	th.CheckEquals(t, 3, multi.Errors[0].Index)
+	th.CheckEquals(t, 7, multi.Errors[1].Index)
+	th.CheckEquals(t, "2 of 10 operations failed: [3] failed; [7] failed", err.Error())
+}
+
+func TestExecuteStopOnError(t *testing.T) {
+	var ops []bulk.Operation
+	for i := 0; i < 5; i++ {
+		i := i
+		ops = append(ops, func() (interface{}, error) {
+			if i == 1 {
+				return nil, errors.New("failed")
+			}
+			return nil, nil
+		})
+	}
+
+	results, err := bulk.Execute(ops, bulk.Opts{Concurrency: 1, StopOnError: true})
+	th.AssertEquals(t, 1, len(err.(*bulk.ErrOperationsFailed).Errors))
+	th.CheckEquals(t, false, results[0].Skipped)
+	th.CheckEquals(t, false, results[1].Skipped)
+	for _, r := range results[2:] {
+		th.CheckEquals(t, true, r.Skipped)
+	}
+}
+
+func TestExecuteStopsOnReauthFailure(t *testing.T) {
+	ops := []bulk.Operation{
+		func() (interface{}, error) {
+			return nil, &gophercloud.ErrUnableToReauthenticate{}
+		},
+		func() (interface{}, error) {
+			return nil, nil
+		},
+	}
+
+	results, err := bulk.Execute(ops, bulk.Opts{Concurrency: 1})
+	th.AssertEquals(t, 1, len(err.(*bulk.ErrOperationsFailed).Errors))
+	th.CheckEquals(t, true, results[1].Skipped)
+}
+
+func TestExecuteRate(t *testing.T) {
+	ops := make([]bulk.Operation, 5)
+	for i := range ops {
+		ops[i] = func() (interface{}, error) {
+			return nil, nil
+		}
+	}
+
+	start := time.Now()
+	_, err := bulk.Execute(ops, bulk.Opts{Rate: 100})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, time.Since(start) >= 40*time.Millisecond)
+}
+
+func TestExecuteConcurrentReauth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	const count = 5
+
+	// Every request made with the expired token is held until all of them
+	// have arrived, so that they are all rejected at once.
+	var rejected sync.WaitGroup
+	rejected.Add(count)
+	th.Mux.HandleFunc("/servers/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		if r.Header.Get("X-Auth-Token") != "new-token" {
+			rejected.Done()
+			rejected.Wait()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var reauths int32
+	provider := &gophercloud.ProviderClient{TokenID: "expired-token"}
+	provider.UseTokenLock()
+	provider.ReauthFunc = func() error {
+		atomic.AddInt32(&reauths, 1)
+		provider.SetToken("new-token")
+		return nil
+	}
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       th.Endpoint(),
+	}
+
+	ops := make([]bulk.Operation, count)
+	for i := range ops {
+		url := client.ServiceURL("servers", string(rune('a'+i)))
+		ops[i] = func() (interface{}, error) {
+			_, err := client.Delete(url, nil)
+			return nil, err
+		}
+	}
+
+	_, err := bulk.Execute(ops, bulk.Opts{Concurrency: count})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, int32(1), atomic.LoadInt32(&reauths))
+}
@@ -1,6 +1,9 @@
 package gophercloud
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // BaseError is an error type that all other error types embed.
 type BaseError struct {
@@ -270,10 +273,42 @@ type ErrMultipleResourcesFound struct {
 	Name         string
 	Count        int
 	ResourceType string
+	// IDs holds the IDs of the matching resources, if they are known.
+	IDs []string
 }
 
 func (e ErrMultipleResourcesFound) Error() string {
 	e.DefaultErrString = fmt.Sprintf("Found %d %ss matching %s", e.Count, e.ResourceType, e.Name)
+	if len(e.IDs) > 0 {
+		e.DefaultErrString += fmt.Sprintf(": %s", strings.Join(e.IDs, ", "))
+	}
+	return e.choseErrString()
+}
+
+// ErrResourceFailed is the error when a resource that is being waited on
+// enters a failed state.
+type ErrResourceFailed struct {
+	BaseError
+	Status string
+}
+
+func (e ErrResourceFailed) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Resource entered failed status %s", e.Status)
+	return e.choseErrString()
+}
+
+// ErrResourceDeleted is the error when a resource that is being waited on is
+// deleted before it becomes ready.
+type ErrResourceDeleted struct {
+	BaseError
+	Status string
+}
+
+func (e ErrResourceDeleted) Error() string {
+	e.DefaultErrString = "Resource was deleted"
+	if e.Status != "" {
+		e.DefaultErrString += fmt.Sprintf(" (status %s)", e.Status)
+	}
 	return e.choseErrString()
 }
 
@@ -0,0 +1,196 @@
+package gophercloud
+
+import (
+	"strings"
+	"time"
+)
+
+// MetricsReporter is implemented by types that want to observe the API
+// traffic issued through a ProviderClient, e.g. to export it as Prometheus
+// histograms.
+//
+// ReportRequest is called synchronously once per call to
+// ProviderClient.Request, after the response has been processed. It should
+// return quickly and must be safe for concurrent use.
+type MetricsReporter interface {
+	ReportRequest(m RequestMetrics)
+}
+
+// RequestMetrics describes a single request issued by a ProviderClient.
+type RequestMetrics struct {
+	// ServiceType is the type of the service the request was addressed to
+	// (e.g. "compute"). It is empty for requests that were not issued through
+	// a ServiceClient.
+	ServiceType string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URLTemplate is the path of the requested URL with any IDs and names
+	// replaced by "{id}". See URLTemplate.
+	URLTemplate string
+
+	// StatusCode is the HTTP status code of the final response, or 0 if no
+	// response was received.
+	StatusCode int
+
+	// Retries is the number of times the request was re-issued.
+	Retries int
+
+	// Reauthenticated reports whether the request triggered a
+	// re-authentication of the ProviderClient.
+	Reauthenticated bool
+
+	// Latency is the time spent in ProviderClient.Request, including any
+	// retries and re-authentication.
+	Latency time.Duration
+
+	// Err is the error returned by ProviderClient.Request, if any.
+	Err error
+}
+
+// URLTemplate returns the path of rawurl with the scheme, host and query
+// string removed and every segment that may hold a resource ID or name
+// replaced by "{id}". It is used to produce low cardinality labels for
+// request metrics.
+//
+// Paths are templated by position: below an optional version segment (e.g.
+// "v2.1"), segments alternate between a collection name, which is kept, and
+// the ID or name of an item of that collection, which is replaced. Segments
+// that look like IDs, such as project IDs and Swift accounts, are replaced
+// wherever they appear, and "detail" and extension namespaces such as
+// "OS-FEDERATION" are kept. For the "object-store" service, every container
+// and object name is replaced.
+func URLTemplate(serviceType, rawurl string) string {
+	if i := strings.Index(rawurl, "://"); i >= 0 {
+		rawurl = rawurl[i+3:]
+		if j := strings.IndexByte(rawurl, '/'); j >= 0 {
+			rawurl = rawurl[j:]
+		} else {
+			rawurl = "/"
+		}
+	}
+	if i := strings.IndexAny(rawurl, "?#"); i >= 0 {
+		rawurl = rawurl[:i]
+	}
+
+	segments := strings.Split(rawurl, "/")
+	if serviceType == "object-store" {
+		return objectStoreURLTemplate(segments)
+	}
+
+	item := false
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case isVersionSegment(segment):
+			item = false
+		case isIDSegment(segment):
+			segments[i] = "{id}"
+			item = false
+		case item:
+			if segment != "detail" {
+				segments[i] = "{id}"
+			}
+			item = false
+		case !isNamespaceSegment(segment):
+			item = true
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// objectStoreURLTemplate templates the path segments of an Object Storage
+// URL.
+func objectStoreURLTemplate(segments []string) string {
+	start := 0
+	if len(segments) > 0 && segments[0] == "" {
+		start = 1
+	}
+	for i, segment := range segments {
+		if isVersionSegment(segment) {
+			start = i + 1
+			break
+		}
+	}
+
+	// The account, container and object names follow the version. Object
+	// names may contain slashes, so they are replaced as a whole.
+	names := segments[start:]
+	if len(names) > 3 {
+		names = names[:3]
+	}
+	for i, name := range names {
+		if name != "" {
+			names[i] = "{id}"
+		}
+	}
+	return strings.Join(segments[:start+len(names)], "/")
+}
+
+// isVersionSegment reports whether a URL path segment is an API version, such
+// as "v3" or "v2.1".
+func isVersionSegment(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && s[i] != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceSegments lists URL path segments that group collections rather
+// than being collections themselves.
+var namespaceSegments = map[string]bool{
+	"auth":   true,
+	"bgpvpn": true,
+	"fw":     true,
+	"fwaas":  true,
+	"lbaas":  true,
+	"qos":    true,
+	"sfc":    true,
+	"vpn":    true,
+}
+
+// isNamespaceSegment reports whether a URL path segment is an extension
+// namespace, such as "OS-TRUST", or one of namespaceSegments.
+func isNamespaceSegment(s string) bool {
+	return strings.HasPrefix(s, "OS-") || namespaceSegments[s]
+}
+
+// isIDSegment reports whether a URL path segment looks like a resource ID.
+func isIDSegment(s string) bool {
+	if s == "" {
+		return false
+	}
+	if strings.HasPrefix(s, "AUTH_") {
+		return true
+	}
+
+	digits, hex, dashes := 0, 0, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+			hex++
+		case c == '-':
+			dashes++
+		default:
+			return false
+		}
+	}
+
+	switch {
+	case digits == len(s):
+		return true
+	case dashes == 4 && len(s) == 36:
+		return true
+	case dashes == 0 && digits > 0 && len(s) >= 16:
+		return true
+	}
+	return false
+}
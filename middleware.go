@@ -0,0 +1,35 @@
+package gophercloud
+
+import "net/http"
+
+// RequestHandler issues a single request on behalf of a ProviderClient. The
+// innermost RequestHandler builds the HTTP request from options, sends it,
+// validates the response status against options.OkCodes, converts failures
+// using options.ErrorContext and decodes the body into options.JSONResponse.
+type RequestHandler func(method, url string, options *RequestOpts) (*http.Response, error)
+
+// Middleware wraps a RequestHandler with additional behavior, such as header
+// injection, auditing, tracing or fault injection.
+//
+// A Middleware may inspect or modify options before calling next, and may
+// inspect the response, the error and the decoded options.JSONResponse after
+// next returns. It can also return without calling next at all.
+//
+// Middleware runs once per HTTP request, which includes every page fetched by
+// a pager and the retry issued after a re-authentication. The request that
+// re-authenticates the ProviderClient runs through the chain as well.
+type Middleware func(next RequestHandler) RequestHandler
+
+// Use appends middleware to the chain of the ProviderClient. Middleware runs
+// in the order it was added: the first one added sees the request first and
+// the response last.
+func (client *ProviderClient) Use(middleware ...Middleware) {
+	client.Middleware = append(client.Middleware, middleware...)
+}
+
+// ServiceType returns the type of the ServiceClient that issued the request,
+// e.g. "compute", or an empty string if the request was issued through the
+// ProviderClient directly.
+func (opts *RequestOpts) ServiceType() string {
+	return opts.serviceType
+}
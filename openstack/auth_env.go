@@ -12,6 +12,10 @@ var nilOptions = gophercloud.AuthOptions{}
 // OS_* environment variables.  The following variables provide sources of truth: OS_AUTH_URL, OS_USERNAME,
 // OS_PASSWORD, OS_TENANT_ID, and OS_TENANT_NAME.  Of these, OS_USERNAME, OS_PASSWORD, and OS_AUTH_URL must
 // have settings, or an error will result.  OS_TENANT_ID and OS_TENANT_NAME are optional.
+//
+// To authenticate with an application credential instead, set OS_APPLICATION_CREDENTIAL_SECRET and either
+// OS_APPLICATION_CREDENTIAL_ID or OS_APPLICATION_CREDENTIAL_NAME. OS_PASSWORD is then not required, and neither are
+// OS_USERNAME and OS_USERID when the credential is identified by ID.
 func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	authURL := os.Getenv("OS_AUTH_URL")
 	username := os.Getenv("OS_USERNAME")
@@ -21,20 +25,35 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	tenantName := os.Getenv("OS_TENANT_NAME")
 	domainID := os.Getenv("OS_DOMAIN_ID")
 	domainName := os.Getenv("OS_DOMAIN_NAME")
+	applicationCredentialID := os.Getenv("OS_APPLICATION_CREDENTIAL_ID")
+	applicationCredentialName := os.Getenv("OS_APPLICATION_CREDENTIAL_NAME")
+	applicationCredentialSecret := os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET")
 
 	if authURL == "" {
 		err := gophercloud.ErrMissingInput{Argument: "authURL"}
 		return nilOptions, err
 	}
 
-	if username == "" && userID == "" {
-		err := gophercloud.ErrMissingInput{Argument: "username"}
-		return nilOptions, err
-	}
+	if applicationCredentialID != "" || applicationCredentialName != "" {
+		if applicationCredentialSecret == "" {
+			err := gophercloud.ErrMissingInput{Argument: "applicationCredentialSecret"}
+			return nilOptions, err
+		}
 
-	if password == "" {
-		err := gophercloud.ErrMissingInput{Argument: "password"}
-		return nilOptions, err
+		if applicationCredentialID == "" && username == "" && userID == "" {
+			err := gophercloud.ErrMissingInput{Argument: "username"}
+			return nilOptions, err
+		}
+	} else {
+		if username == "" && userID == "" {
+			err := gophercloud.ErrMissingInput{Argument: "username"}
+			return nilOptions, err
+		}
+
+		if password == "" {
+			err := gophercloud.ErrMissingInput{Argument: "password"}
+			return nilOptions, err
+		}
 	}
 
 	ao := gophercloud.AuthOptions{
@@ -46,6 +65,10 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 		TenantName:       tenantName,
 		DomainID:         domainID,
 		DomainName:       domainName,
+
+		ApplicationCredentialID:     applicationCredentialID,
+		ApplicationCredentialName:   applicationCredentialName,
+		ApplicationCredentialSecret: applicationCredentialSecret,
 	}
 
 	return ao, nil
@@ -1,6 +1,8 @@
 package snapshots
 
 import (
+	"strings"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -47,6 +49,21 @@ type Snapshot struct {
 	Size int `json:"size"`
 }
 
+// State implements gophercloud.StatefulResource.
+func (r Snapshot) State() (gophercloud.ResourceState, string) {
+	switch {
+	case r.Status == "available":
+		return gophercloud.StateReady, r.Status
+	case r.Status == "deleted":
+		return gophercloud.StateDeleted, r.Status
+	case r.Status == "error_deleting":
+		return gophercloud.StateDeleteFailed, r.Status
+	case strings.HasPrefix(r.Status, "error"):
+		return gophercloud.StateFailed, r.Status
+	}
+	return gophercloud.StatePending, r.Status
+}
+
 // CreateResult contains the response body and error from a Create request.
 type CreateResult struct {
 	commonResult
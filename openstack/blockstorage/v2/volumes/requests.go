@@ -2,6 +2,7 @@ package volumes
 
 import (
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -150,33 +151,27 @@ func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder
 	return
 }
 
-// IDFromName is a convienience function that returns a server's ID given its name.
+// IDFromName is a convenience function that returns a volume's ID given its name.
 func IDFromName(client *gophercloud.ServiceClient, name string) (string, error) {
-	count := 0
-	id := ""
-	pages, err := List(client, nil).AllPages()
-	if err != nil {
-		return "", err
-	}
-
-	all, err := ExtractVolumes(pages)
-	if err != nil {
-		return "", err
-	}
+	return utils.IDFromName(name, findOpts(client))
+}
 
-	for _, s := range all {
-		if s.Name == name {
-			count++
-			id = s.ID
-		}
-	}
+// FindID is a convenience function that returns a volume's ID given either its
+// ID or its name.
+func FindID(client *gophercloud.ServiceClient, nameOrID string) (string, error) {
+	return utils.FindID(nameOrID, findOpts(client))
+}
 
-	switch count {
-	case 0:
-		return "", gophercloud.ErrResourceNotFound{Name: name, ResourceType: "volume"}
-	case 1:
-		return id, nil
-	default:
-		return "", gophercloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "volume"}
+// findOpts describes how IDFromName and FindID look up a volume.
+func findOpts(client *gophercloud.ServiceClient) utils.FindOpts {
+	return utils.FindOpts{
+		ResourceType: "volume",
+		Key:          "volumes",
+		Get: func(id string) error {
+			return Get(client, id).Err
+		},
+		List: func(name string) pagination.Pager {
+			return List(client, ListOpts{Name: name})
+		},
 	}
 }
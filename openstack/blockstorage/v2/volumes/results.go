@@ -1,6 +1,8 @@
 package volumes
 
 import (
+	"strings"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -56,6 +58,21 @@ type Volume struct {
 	Multiattach bool `json:"multiattach"`
 }
 
+// State implements gophercloud.StatefulResource.
+func (r Volume) State() (gophercloud.ResourceState, string) {
+	switch {
+	case r.Status == "available", r.Status == "in-use":
+		return gophercloud.StateReady, r.Status
+	case r.Status == "deleted":
+		return gophercloud.StateDeleted, r.Status
+	case r.Status == "error_deleting":
+		return gophercloud.StateDeleteFailed, r.Status
+	case strings.HasPrefix(r.Status, "error"):
+		return gophercloud.StateFailed, r.Status
+	}
+	return gophercloud.StatePending, r.Status
+}
+
 /*
 THESE BELONG IN EXTENSIONS:
 // ReplicationDriverData contains data about the replication driver.
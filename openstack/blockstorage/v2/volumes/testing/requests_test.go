@@ -210,3 +210,18 @@ func TestUpdate(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "vol-002", v.Name)
 }
+
+func TestVolumeState(t *testing.T) {
+	states := map[string]gophercloud.ResourceState{
+		"creating":       gophercloud.StatePending,
+		"available":      gophercloud.StateReady,
+		"in-use":         gophercloud.StateReady,
+		"error":          gophercloud.StateFailed,
+		"error_deleting": gophercloud.StateDeleteFailed,
+		"deleting":       gophercloud.StatePending,
+	}
+	for status, expected := range states {
+		state, _ := volumes.Volume{Status: status}.State()
+		th.CheckEquals(t, expected, state)
+	}
+}
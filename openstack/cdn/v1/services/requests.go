@@ -260,9 +260,8 @@ func Update(c *gophercloud.ServiceClient, idOrURL string, opts UpdateOpts) (r Up
 		b[i] = patch.ToCDNServiceUpdateMap()
 	}
 
-	resp, err := c.Request("PATCH", url, &gophercloud.RequestOpts{
-		JSONBody: &b,
-		OkCodes:  []int{202},
+	resp, err := c.Patch(url, &b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
 	})
 	r.Header = resp.Header
 	r.Err = err
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	tokens2 "github.com/gophercloud/gophercloud/openstack/identity/v2/tokens"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2tokens"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/oauth1"
 	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/openstack/utils"
 )
@@ -31,17 +33,16 @@ func NewClient(endpoint string) (*gophercloud.ProviderClient, error) {
 	endpoint = gophercloud.NormalizeURL(endpoint)
 	base = gophercloud.NormalizeURL(base)
 
+	p := &gophercloud.ProviderClient{
+		IdentityBase:     base,
+		IdentityEndpoint: "",
+	}
 	if hadPath {
-		return &gophercloud.ProviderClient{
-			IdentityBase:     base,
-			IdentityEndpoint: endpoint,
-		}, nil
+		p.IdentityEndpoint = endpoint
 	}
+	p.UseTokenLock()
 
-	return &gophercloud.ProviderClient{
-		IdentityBase:     base,
-		IdentityEndpoint: "",
-	}, nil
+	return p, nil
 }
 
 // AuthenticatedClient logs in to an OpenStack cloud found at the identity endpoint specified by options, acquires a token, and
@@ -75,9 +76,13 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 	switch chosen.ID {
 	case v20:
-		return v2auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		return authenticate(client, options.AllowReauth, func() error {
+			return v2auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		})
 	case v30:
-		return v3auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		return authenticate(client, options.AllowReauth, func() error {
+			return v3auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		})
 	default:
 		// The switch statement must be out of date from the versions list.
 		return fmt.Errorf("Unrecognized identity version: %s", chosen.ID)
@@ -86,11 +91,26 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 // AuthenticateV2 explicitly authenticates against the identity v2 endpoint.
 func AuthenticateV2(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	return v2auth(client, "", options, eo)
+	return authenticate(client, options.AllowReauth, func() error {
+		return v2auth(client, "", options, eo)
+	})
+}
+
+// authenticate calls auth and, if allowReauth is set, makes it the ReauthFunc
+// of client. auth must only replace the token state of client, with SetAuth:
+// it runs again on re-authentication, while other requests may be in flight.
+func authenticate(client *gophercloud.ProviderClient, allowReauth bool, auth func() error) error {
+	if err := auth(); err != nil {
+		return err
+	}
+	if allowReauth {
+		client.SetReauthFunc(auth)
+	}
+	return nil
 }
 
 func v2auth(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	v2Client, err := NewIdentityV2(client, eo)
+	v2Client, err := NewIdentityV2(authClient(client), eo)
 	if err != nil {
 		return err
 	}
@@ -121,28 +141,39 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
-	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v2auth(client, endpoint, options, eo)
-		}
+	user, err := tokens2.GetResult{CreateResult: result}.ExtractUser()
+	if err != nil {
+		return err
 	}
-	client.TokenID = token.ID
-	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
-		return V2EndpointURL(catalog, opts)
+
+	identity := &gophercloud.AuthIdentity{
+		UserID:      user.ID,
+		UserName:    user.Name,
+		ProjectID:   token.Tenant.ID,
+		ProjectName: token.Tenant.Name,
+		ExpiresAt:   token.ExpiresAt,
 	}
+	for _, role := range user.Roles {
+		identity.Roles = append(identity.Roles, gophercloud.AuthRole{Name: role.Name})
+	}
+
+	client.SetAuth(token.ID, identity, func(opts gophercloud.EndpointOpts) (string, error) {
+		return V2EndpointURL(catalog, opts)
+	})
 
 	return nil
 }
 
 // AuthenticateV3 explicitly authenticates against the identity v3 service.
 func AuthenticateV3(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	return v3auth(client, "", options, eo)
+	return authenticate(client, options.AllowReauth, func() error {
+		return v3auth(client, "", options, eo)
+	})
 }
 
 func v3auth(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
 	// Override the generated service endpoint with the one returned by the version endpoint.
-	v3Client, err := NewIdentityV3(client, eo)
+	v3Client, err := NewIdentityV3(authClient(client), eo)
 	if err != nil {
 		return err
 	}
@@ -156,7 +187,29 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 	v3Options := options
 
 	var scope *tokens3.Scope
-	if options.TenantID != "" {
+	if options.Scope != nil {
+		scope = &tokens3.Scope{
+			ProjectID:   options.Scope.ProjectID,
+			ProjectName: options.Scope.ProjectName,
+			DomainID:    options.Scope.DomainID,
+			DomainName:  options.Scope.DomainName,
+			System:      options.Scope.System,
+			Unscoped:    options.Scope.Unscoped,
+			TrustID:     options.TrustID,
+		}
+		v3Options.TenantID = ""
+		v3Options.TenantName = ""
+	} else if options.ApplicationCredentialID != "" || options.ApplicationCredentialName != "" {
+		// Application credentials are bound to a project and may not be scoped.
+		v3Options.TenantID = ""
+		v3Options.TenantName = ""
+	} else if options.TrustID != "" {
+		scope = &tokens3.Scope{
+			TrustID: options.TrustID,
+		}
+		v3Options.TenantID = ""
+		v3Options.TenantName = ""
+	} else if options.TenantID != "" {
 		scope = &tokens3.Scope{
 			ProjectID: options.TenantID,
 		}
@@ -184,6 +237,10 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		TenantName:       v3Options.TenantName,
 		AllowReauth:      v3Options.AllowReauth,
 		TokenID:          v3Options.TokenID,
+
+		ApplicationCredentialID:     v3Options.ApplicationCredentialID,
+		ApplicationCredentialName:   v3Options.ApplicationCredentialName,
+		ApplicationCredentialSecret: v3Options.ApplicationCredentialSecret,
 	}
 
 	result := tokens3.Create(v3Client, v3Opts, scope)
@@ -198,27 +255,170 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
-	client.TokenID = token.ID
+	identity, err := result.ExtractAuthIdentity()
+	if err != nil {
+		return err
+	}
+
+	client.SetAuth(token.ID, identity, func(opts gophercloud.EndpointOpts) (string, error) {
+		return V3EndpointURL(catalog, opts)
+	})
 
-	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v3auth(client, endpoint, options, eo)
-		}
+	return nil
+}
+
+// AuthenticateV3EC2 authenticates against the identity v3 service with an
+// EC2 credential, i.e. an access/secret key pair, instead of a password. The
+// token is scoped to the project of the credential. If opts.AllowReauth is
+// set, each re-authentication signs a new request, so opts.Timestamp should
+// be left unset.
+func AuthenticateV3EC2(client *gophercloud.ProviderClient, opts ec2tokens.AuthOptions, eo gophercloud.EndpointOpts) error {
+	return authenticate(client, opts.AllowReauth, func() error {
+		return v3ec2auth(client, opts, eo)
+	})
+}
+
+func v3ec2auth(client *gophercloud.ProviderClient, opts ec2tokens.AuthOptions, eo gophercloud.EndpointOpts) error {
+	v3Client, err := NewIdentityV3(authClient(client), eo)
+	if err != nil {
+		return err
+	}
+
+	result := ec2tokens.Create(v3Client, opts)
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return err
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return err
+	}
+
+	identity, err := result.ExtractAuthIdentity()
+	if err != nil {
+		return err
+	}
+
+	client.SetAuth(token.ID, identity, func(opts gophercloud.EndpointOpts) (string, error) {
+		return V3EndpointURL(catalog, opts)
+	})
+
+	return nil
+}
+
+// AuthenticateV3OAuth1 authenticates against the identity v3 service as an
+// OAuth1 consumer, with an access token delegated by a user. The token is
+// scoped to the project of the access token. If opts.AllowReauth is set, each
+// re-authentication signs a new request, so opts.Timestamp and opts.Nonce
+// should be left unset.
+func AuthenticateV3OAuth1(client *gophercloud.ProviderClient, opts oauth1.AuthOptions, eo gophercloud.EndpointOpts) error {
+	return authenticate(client, opts.AllowReauth, func() error {
+		return v3oauth1auth(client, opts, eo)
+	})
+}
+
+func v3oauth1auth(client *gophercloud.ProviderClient, opts oauth1.AuthOptions, eo gophercloud.EndpointOpts) error {
+	v3Client, err := NewIdentityV3(authClient(client), eo)
+	if err != nil {
+		return err
+	}
+
+	result := oauth1.Create(v3Client, opts)
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return err
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return err
 	}
-	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+
+	identity, err := result.ExtractAuthIdentity()
+	if err != nil {
+		return err
+	}
+
+	client.SetAuth(token.ID, identity, func(opts gophercloud.EndpointOpts) (string, error) {
 		return V3EndpointURL(catalog, opts)
+	})
+
+	return nil
+}
+
+// RescopeV3 exchanges the token of an authenticated ProviderClient for a token
+// with a different scope, e.g. another project, and updates the client to use
+// it. No credentials are needed. If the client re-authenticates, for example
+// because it was authenticated with AllowReauth, the new token is rescoped
+// again after each re-authentication.
+func RescopeV3(client *gophercloud.ProviderClient, scope *tokens3.Scope, eo gophercloud.EndpointOpts) error {
+	if err := v3rescope(client, scope, eo); err != nil {
+		return err
+	}
+
+	if reauth := client.GetReauthFunc(); reauth != nil {
+		client.SetReauthFunc(func() error {
+			if err := reauth(); err != nil {
+				return err
+			}
+			return v3rescope(client, scope, eo)
+		})
+	}
+
+	return nil
+}
+
+func v3rescope(client *gophercloud.ProviderClient, scope *tokens3.Scope, eo gophercloud.EndpointOpts) error {
+	v3Client, err := NewIdentityV3(authClient(client), eo)
+	if err != nil {
+		return err
+	}
+
+	result := tokens3.Create(v3Client, tokens3.AuthOptions{TokenID: client.Token()}, scope)
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return err
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return err
 	}
 
+	identity, err := result.ExtractAuthIdentity()
+	if err != nil {
+		return err
+	}
+
+	client.SetAuth(token.ID, identity, func(opts gophercloud.EndpointOpts) (string, error) {
+		return V3EndpointURL(catalog, opts)
+	})
+
 	return nil
 }
 
+// authClient returns a copy of client that does not re-authenticate, for
+// issuing the requests that authenticate client. A 401 response to such a
+// request means that the credentials or the token are no longer valid, and
+// must be returned rather than answered by authenticating again with the same
+// request.
+func authClient(client *gophercloud.ProviderClient) *gophercloud.ProviderClient {
+	c := client.Copy()
+	c.ReauthFunc = nil
+	c.TokenID = ""
+	return c
+}
+
 // NewIdentityV2 creates a ServiceClient that may be used to interact with the v2 identity service.
 func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	v2Endpoint := client.IdentityBase + "v2.0/"
 	/*
 		eo.ApplyDefaults("identity")
-		url, err := client.EndpointLocator(eo)
+		url, err := client.LocateEndpoint(eo)
 		if err != nil {
 			return nil, err
 		}
@@ -227,6 +427,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v2Endpoint,
+		Type:           "identity",
 		//Endpoint: url,
 	}, nil
 }
@@ -236,7 +437,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	v3Endpoint := client.IdentityBase + "v3/"
 	/*
 		eo.ApplyDefaults("identity")
-		url, err := client.EndpointLocator(eo)
+		url, err := client.LocateEndpoint(eo)
 		if err != nil {
 			return nil, err
 		}
@@ -245,6 +446,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v3Endpoint,
+		Type:           "identity",
 		//Endpoint: url,
 	}, nil
 }
@@ -252,33 +454,34 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 // NewObjectStorageV1 creates a ServiceClient that may be used with the v1 object storage package.
 func NewObjectStorageV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("object-store")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewComputeV2 creates a ServiceClient that may be used with the v2 compute package.
 func NewComputeV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("compute")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewNetworkV2 creates a ServiceClient that may be used with the v2 network package.
 func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("network")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       url,
+		Type:           eo.Type,
 		ResourceBase:   url + "v2.0/",
 	}, nil
 }
@@ -286,64 +489,65 @@ func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 // NewBlockStorageV1 creates a ServiceClient that may be used to access the v1 block storage service.
 func NewBlockStorageV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("volume")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewBlockStorageV2 creates a ServiceClient that may be used to access the v2 block storage service.
 func NewBlockStorageV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("volumev2")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewCDNV1 creates a ServiceClient that may be used to access the OpenStack v1
 // CDN service.
 func NewCDNV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("cdn")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewOrchestrationV1 creates a ServiceClient that may be used to access the v1 orchestration service.
 func NewOrchestrationV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("orchestration")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewDBV1 creates a ServiceClient that may be used to access the v1 DB service.
 func NewDBV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("database")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewContainerOrchestrationV1 creates a ServiceClient that may be used with the v1 container orchestration package.
 func NewContainerOrchestrationV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("container-infra")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       url,
+		Type:           eo.Type,
 		ResourceBase:   url + "v1/",
 	}, nil
 }
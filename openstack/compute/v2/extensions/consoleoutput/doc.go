@@ -0,0 +1,35 @@
+/*
+Package consoleoutput provides functionality to retrieve the console output
+of servers that have been provisioned by the OpenStack Compute service.
+
+Example to Show the Last Lines of the Console Output
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	showOpts := consoleoutput.ShowOpts{
+		Length: 50,
+	}
+
+	output, err := consoleoutput.Show(computeClient, serverID, showOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(output)
+
+Example to Tail the Console Output
+
+	tailer := consoleoutput.NewTailer(computeClient, serverID, 100)
+	for {
+		lines, err := tailer.Next()
+		if err != nil {
+			panic(err)
+		}
+
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+*/
+package consoleoutput
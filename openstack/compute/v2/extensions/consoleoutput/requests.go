@@ -0,0 +1,43 @@
+package consoleoutput
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ShowOptsBuilder allows extensions to add additional parameters to the Show
+// request.
+type ShowOptsBuilder interface {
+	ToConsoleOutputShowMap() (map[string]interface{}, error)
+}
+
+// ShowOpts represents the options used to retrieve the console output of a
+// server.
+type ShowOpts struct {
+	// Length is the number of lines to retrieve from the end of the output.
+	// If zero, the whole output is retrieved.
+	Length int `json:"length,omitempty"`
+}
+
+// ToConsoleOutputShowMap formats a ShowOpts as a map that can be used as a
+// JSON request body for the Show request.
+func (opts ShowOpts) ToConsoleOutputShowMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "os-getConsoleOutput")
+}
+
+// Show retrieves the console output of a Compute server. opts may be nil.
+func Show(client *gophercloud.ServiceClient, id string, opts ShowOptsBuilder) (r ShowResult) {
+	if opts == nil {
+		opts = ShowOpts{}
+	}
+	b, err := opts.ToConsoleOutputShowMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
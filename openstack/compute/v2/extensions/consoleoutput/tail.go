@@ -0,0 +1,70 @@
+package consoleoutput
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Tailer retrieves the console output of a server incrementally. Each call to
+// Next returns the lines written to the console since the previous call. It
+// is not safe for concurrent use.
+type Tailer struct {
+	client   *gophercloud.ServiceClient
+	serverID string
+	length   int
+
+	// seen holds the complete lines of the last retrieved output.
+	seen []string
+}
+
+// NewTailer returns a Tailer for the console output of the given server.
+// length is the number of lines retrieved on every call to Next, and should
+// be larger than the number of lines expected between two calls. If it is
+// zero, the whole output is retrieved every time.
+func NewTailer(client *gophercloud.ServiceClient, serverID string, length int) *Tailer {
+	return &Tailer{client: client, serverID: serverID, length: length}
+}
+
+// Next retrieves the console output and returns the complete lines that were
+// not returned by previous calls. A trailing line that has not been
+// terminated yet is held back until it is.
+func (t *Tailer) Next() ([]string, error) {
+	output, err := Show(t.client, t.serverID, ShowOpts{Length: t.length}).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	// The last element is either empty or a line that is not terminated yet.
+	lines := strings.SplitAfter(output, "\n")
+	lines = lines[:len(lines)-1]
+
+	// The retrieved window starts somewhere within the previous one, so the
+	// new lines are those following the longest suffix of the previous window
+	// that the new window starts with.
+	overlap := len(t.seen)
+	if overlap > len(lines) {
+		overlap = len(lines)
+	}
+	for ; overlap > 0; overlap-- {
+		if equalLines(t.seen[len(t.seen)-overlap:], lines[:overlap]) {
+			break
+		}
+	}
+
+	var next []string
+	for _, line := range lines[overlap:] {
+		next = append(next, strings.TrimRight(line, "\r\n"))
+	}
+	t.seen = lines
+	return next, nil
+}
+
+func equalLines(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
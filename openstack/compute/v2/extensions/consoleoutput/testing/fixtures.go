@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ShowRequest is the body of a Show request limited to 50 lines.
+const ShowRequest = `
+{
+	"os-getConsoleOutput": {
+		"length": 50
+	}
+}
+`
+
+// ShowOutput is the body of a Show response.
+const ShowOutput = `
+{
+	"output": "FAKE CONSOLE OUTPUT\nANOTHER\nLAST LINE"
+}
+`
+
+// HandleShowSuccessfully configures the test server to respond to a Show
+// request with the given request body.
+func HandleShowSuccessfully(t *testing.T, id, request string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ShowOutput)
+	})
+}
+
+// TailOutputs are the bodies returned by successive Show requests while the
+// console output of a server is tailed with a length of 3 lines.
+var TailOutputs = []string{
+	`{"output": ""}`,
+	`{"output": "cloud-init starting\nfetching metadata\nrunning user-d"}`,
+	`{"output": "cloud-init starting\nfetching metadata\nrunning user-data\n"}`,
+	`{"output": "fetching metadata\nrunning user-data\nuser-data failed\n"}`,
+	`{"output": "fetching metadata\nrunning user-data\nuser-data failed\n"}`,
+	`{"output": "cloud-init finished\nlogin: \nlogin: \n"}`,
+}
+
+// ExpectedTailLines are the lines returned by successive calls to Next while
+// the console output of a server is tailed.
+var ExpectedTailLines = [][]string{
+	nil,
+	{"cloud-init starting", "fetching metadata"},
+	{"running user-data"},
+	{"user-data failed"},
+	nil,
+	{"cloud-init finished", "login: ", "login: "},
+}
+
+// HandleTailSuccessfully configures the test server to respond to successive
+// Show requests with TailOutputs.
+func HandleTailSuccessfully(t *testing.T, id string) {
+	var i int
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"os-getConsoleOutput": {"length": 3}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, TailOutputs[i])
+		i++
+	})
+}
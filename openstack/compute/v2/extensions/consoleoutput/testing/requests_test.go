@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/consoleoutput"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestShow(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleShowSuccessfully(t, serverID, ShowRequest)
+
+	output, err := consoleoutput.Show(client.ServiceClient(), serverID, consoleoutput.ShowOpts{
+		Length: 50,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "FAKE CONSOLE OUTPUT\nANOTHER\nLAST LINE", output)
+}
+
+func TestShowWithoutOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleShowSuccessfully(t, serverID, `{"os-getConsoleOutput": {}}`)
+
+	_, err := consoleoutput.Show(client.ServiceClient(), serverID, nil).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestTailer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleTailSuccessfully(t, serverID)
+
+	tailer := consoleoutput.NewTailer(client.ServiceClient(), serverID, 3)
+	for _, expected := range ExpectedTailLines {
+		lines, err := tailer.Next()
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, expected, lines)
+	}
+}
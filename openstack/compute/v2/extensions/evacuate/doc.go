@@ -0,0 +1,18 @@
+/*
+Package evacuate provides functionality to evacuate servers that have been
+provisioned by the OpenStack Compute service from a failed host.
+
+Example to Evacuate a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	evacuateOpts := evacuate.EvacuateOpts{
+		Host:      "derp",
+		AdminPass: "MySecretPass",
+	}
+
+	adminPass, err := evacuate.Evacuate(computeClient, serverID, evacuateOpts).ExtractAdminPass()
+	if err != nil {
+		panic(err)
+	}
+*/
+package evacuate
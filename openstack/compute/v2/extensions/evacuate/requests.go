@@ -0,0 +1,71 @@
+package evacuate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// EvacuateOptsBuilder allows extensions to add additional parameters to the
+// Evacuate request.
+type EvacuateOptsBuilder interface {
+	ToEvacuateMap() (map[string]interface{}, error)
+}
+
+// EvacuateOpts specifies Evacuate action parameters.
+type EvacuateOpts struct {
+	// Host is the name of the target host. If left blank, the scheduler
+	// chooses one.
+	Host string `json:"host,omitempty"`
+
+	// AdminPass is the administrative password of the evacuated server. If
+	// left blank, the server generates one.
+	AdminPass string `json:"adminPass,omitempty"`
+
+	// OnSharedStorage sets whether the server files are on shared storage. It
+	// was removed in microversion 2.14.
+	OnSharedStorage *bool `json:"onSharedStorage,omitempty"`
+
+	// Force forces the evacuation to Host, bypassing the scheduler. It
+	// requires microversion 2.29 or later.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ToEvacuateMap constructs a request body from EvacuateOpts.
+func (opts EvacuateOpts) ToEvacuateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "evacuate")
+}
+
+// Evacuate is the operation responsible for evacuating a Compute server from
+// a failed host.
+func Evacuate(client *gophercloud.ServiceClient, id string, opts EvacuateOptsBuilder) (r EvacuateResult) {
+	b, err := opts.ToEvacuateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+	defer resp.Body.Close()
+
+	// Since microversion 2.14 the response has no body.
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if len(body) > 0 {
+		r.Err = json.Unmarshal(body, &r.Body)
+	}
+	return
+}
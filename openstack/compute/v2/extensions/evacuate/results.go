@@ -0,0 +1,23 @@
+package evacuate
+
+import "github.com/gophercloud/gophercloud"
+
+// EvacuateResult is the response from an Evacuate operation. Call its
+// ExtractAdminPass method to retrieve the admin password of the server.
+type EvacuateResult struct {
+	gophercloud.Result
+}
+
+// ExtractAdminPass interprets an EvacuateResult as the admin password of the
+// evacuated server. The password is empty since microversion 2.14, which no
+// longer returns it.
+func (r EvacuateResult) ExtractAdminPass() (string, error) {
+	var s struct {
+		AdminPass string `json:"adminPass"`
+	}
+	if r.Err != nil || r.Body == nil {
+		return "", r.Err
+	}
+	err := r.ExtractInto(&s)
+	return s.AdminPass, err
+}
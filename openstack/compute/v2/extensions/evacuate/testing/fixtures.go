@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// EvacuateRequest is the body of an Evacuate request.
+const EvacuateRequest = `
+{
+	"evacuate": {
+		"host": "derp",
+		"adminPass": "MySecretPass",
+		"onSharedStorage": false
+	}
+}
+`
+
+// EvacuateOutput is the body of an Evacuate response before microversion
+// 2.14.
+const EvacuateOutput = `
+{
+	"adminPass": "MySecretPass"
+}
+`
+
+// HandleEvacuateSuccessfully configures the test server to respond to an
+// Evacuate request with the given body, which may be empty.
+func HandleEvacuateSuccessfully(t *testing.T, id, output string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, EvacuateRequest)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, output)
+	})
+}
@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/evacuate"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+var onSharedStorage = false
+
+func TestEvacuate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleEvacuateSuccessfully(t, serverID, EvacuateOutput)
+
+	adminPass, err := evacuate.Evacuate(client.ServiceClient(), serverID, evacuate.EvacuateOpts{
+		Host:            "derp",
+		AdminPass:       "MySecretPass",
+		OnSharedStorage: &onSharedStorage,
+	}).ExtractAdminPass()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "MySecretPass", adminPass)
+}
+
+func TestEvacuateNoBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleEvacuateSuccessfully(t, serverID, "")
+
+	adminPass, err := evacuate.Evacuate(client.ServiceClient(), serverID, evacuate.EvacuateOpts{
+		Host:            "derp",
+		AdminPass:       "MySecretPass",
+		OnSharedStorage: &onSharedStorage,
+	}).ExtractAdminPass()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", adminPass)
+}
@@ -0,0 +1,15 @@
+/*
+Package injectnetworkinfo provides functionality to inject network
+information into servers that have been provisioned by the OpenStack Compute
+service. It is an administrative action, and only supported by the Xen
+driver.
+
+Example to Inject Network Information into a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := injectnetworkinfo.InjectNetworkInfo(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package injectnetworkinfo
@@ -0,0 +1,16 @@
+package injectnetworkinfo
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// InjectNetworkInfo is the operation responsible for injecting the network
+// information of a Compute server into the server.
+func InjectNetworkInfo(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"injectNetworkInfo": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,31 @@
+/*
+Package lockunlock provides functionality to lock and unlock servers that
+have been provisioned by the OpenStack Compute service. A locked server can
+only be acted upon by administrators or by the user who locked it.
+
+Example to Lock and Unlock a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := lockunlock.Lock(computeClient, serverID, nil).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = lockunlock.Unlock(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Lock a Server with a Reason
+
+	lockOpts := lockunlock.LockOpts{
+		Reason: "maintenance",
+	}
+
+	computeClient.Microversion = "2.73"
+	err := lockunlock.Lock(computeClient, serverID, lockOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package lockunlock
@@ -0,0 +1,55 @@
+package lockunlock
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// LockOptsBuilder allows extensions to add additional parameters to the Lock
+// request.
+type LockOptsBuilder interface {
+	ToServerLockMap() (map[string]interface{}, error)
+}
+
+// LockOpts represents the options used to lock a server.
+type LockOpts struct {
+	// Reason is the reason the server is being locked. It requires
+	// microversion 2.73 or later.
+	Reason string `json:"locked_reason,omitempty"`
+}
+
+// ToServerLockMap formats a LockOpts as a map that can be used as a JSON
+// request body for the Lock request.
+func (opts LockOpts) ToServerLockMap() (map[string]interface{}, error) {
+	if opts.Reason == "" {
+		return map[string]interface{}{"lock": nil}, nil
+	}
+	return gophercloud.BuildRequestBody(opts, "lock")
+}
+
+// Lock is the operation responsible for locking a Compute server. opts may be
+// nil.
+func Lock(client *gophercloud.ServiceClient, id string, opts LockOptsBuilder) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{"lock": nil}
+	if opts != nil {
+		var err error
+		b, err = opts.ToServerLockMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Unlock is the operation responsible for unlocking a Compute server.
+func Unlock(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unlock": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
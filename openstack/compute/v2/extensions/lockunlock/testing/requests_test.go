@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/lockunlock"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestLock(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLockServerResponse(t, serverID, `{"lock": null}`)
+
+	err := lockunlock.Lock(client.ServiceClient(), serverID, nil).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLockWithReason(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLockServerResponse(t, serverID, `{"lock": {"locked_reason": "maintenance"}}`)
+
+	err := lockunlock.Lock(client.ServiceClient(), serverID, lockunlock.LockOpts{
+		Reason: "maintenance",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnlock(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockUnlockServerResponse(t, serverID)
+
+	err := lockunlock.Unlock(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
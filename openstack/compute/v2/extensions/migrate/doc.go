@@ -0,0 +1,28 @@
+/*
+Package migrate provides functionality to migrate servers that have been
+provisioned by the OpenStack Compute service.
+
+Example to Cold Migrate a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := migrate.Migrate(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Live-Migrate a Server
+
+	host := "01c0cadef72d47e28a672a76060d492c"
+	blockMigration := false
+
+	migrationOpts := migrate.LiveMigrateOpts{
+		Host:           &host,
+		BlockMigration: &blockMigration,
+	}
+
+	err := migrate.LiveMigrate(computeClient, serverID, migrationOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package migrate
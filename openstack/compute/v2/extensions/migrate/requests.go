@@ -0,0 +1,106 @@
+package migrate
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Migrate is the operation responsible for cold migrating a Compute server to
+// a host chosen by the scheduler.
+func Migrate(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"migrate": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// LiveMigrateOptsBuilder allows extensions to add additional parameters to the
+// LiveMigrate request.
+type LiveMigrateOptsBuilder interface {
+	ToLiveMigrateMap() (map[string]interface{}, error)
+}
+
+// LiveMigrateOpts specifies parameters of live migrate action.
+type LiveMigrateOpts struct {
+	// Host is the destination host. If nil, the scheduler chooses one.
+	Host *string `json:"host"`
+
+	// BlockMigration sets whether the migration should be a block migration.
+	// If nil, LiveMigrate sends "auto" from microversion 2.25 on, and false
+	// before it, when "auto" is not accepted.
+	BlockMigration *bool `json:"block_migration,omitempty"`
+
+	// DiskOverCommit sets whether to allow disk over-commit on the destination
+	// host. It was removed in microversion 2.25. If nil, LiveMigrate sends
+	// false before microversion 2.25, which requires it.
+	DiskOverCommit *bool `json:"disk_over_commit,omitempty"`
+
+	// Force forces the migration to Host, bypassing the scheduler. It
+	// requires microversion 2.30 or later.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ToLiveMigrateMap constructs a request body from LiveMigrateOpts.
+func (opts LiveMigrateOpts) ToLiveMigrateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "os-migrateLive")
+}
+
+// LiveMigrate is the operation responsible for live migrating a Compute
+// server.
+func LiveMigrate(client *gophercloud.ServiceClient, id string, opts LiveMigrateOptsBuilder) (r gophercloud.ErrResult) {
+	b, err := opts.ToLiveMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if body, ok := b["os-migrateLive"].(map[string]interface{}); ok {
+		setLiveMigrateDefaults(body, client.Microversion)
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// setLiveMigrateDefaults fills in the parameters of a live migration that
+// microversion requires but the caller left out.
+func setLiveMigrateDefaults(body map[string]interface{}, microversion string) {
+	if atLeast(microversion, 2, 25) {
+		if _, ok := body["block_migration"]; !ok {
+			body["block_migration"] = "auto"
+		}
+		return
+	}
+	for _, k := range []string{"block_migration", "disk_over_commit"} {
+		if _, ok := body[k]; !ok {
+			body[k] = false
+		}
+	}
+}
+
+// atLeast reports whether microversion, e.g. "2.25", is major.minor or later.
+// An empty microversion is the minimum one.
+func atLeast(microversion string, major, minor int) bool {
+	if microversion == "latest" {
+		return true
+	}
+	parts := strings.SplitN(microversion, ".", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	actualMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	actualMinor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	return actualMajor > major || (actualMajor == major && actualMinor >= minor)
+}
@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// LiveMigrateRequest is the body of a live migration to a specific host.
+const LiveMigrateRequest = `
+{
+	"os-migrateLive": {
+		"host": "01c0cadef72d47e28a672a76060d492c",
+		"block_migration": false,
+		"force": true
+	}
+}
+`
+
+// LiveMigrateAutoRequest is the body of a live migration to a host chosen by
+// the scheduler, from microversion 2.25 on.
+const LiveMigrateAutoRequest = `
+{
+	"os-migrateLive": {
+		"host": null,
+		"block_migration": "auto"
+	}
+}
+`
+
+// LiveMigrateDefaultRequest is the body of a live migration to a host chosen
+// by the scheduler, before microversion 2.25.
+const LiveMigrateDefaultRequest = `
+{
+	"os-migrateLive": {
+		"host": null,
+		"block_migration": false,
+		"disk_over_commit": false
+	}
+}
+`
+
+func mockMigrateResponse(t *testing.T, id string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"migrate": null}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func mockLiveMigrateResponse(t *testing.T, id, body string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, body)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrate"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockMigrateResponse(t, serverID)
+
+	err := migrate.Migrate(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLiveMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLiveMigrateResponse(t, serverID, LiveMigrateRequest)
+
+	host := "01c0cadef72d47e28a672a76060d492c"
+	blockMigration := false
+	force := true
+	opts := migrate.LiveMigrateOpts{
+		Host:           &host,
+		BlockMigration: &blockMigration,
+		Force:          &force,
+	}
+	sc := client.ServiceClient()
+	sc.Microversion = "2.30"
+	err := migrate.LiveMigrate(sc, serverID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLiveMigrateAuto(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLiveMigrateResponse(t, serverID, LiveMigrateAutoRequest)
+
+	sc := client.ServiceClient()
+	sc.Microversion = "2.25"
+	err := migrate.LiveMigrate(sc, serverID, migrate.LiveMigrateOpts{}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLiveMigrateDefault(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLiveMigrateResponse(t, serverID, LiveMigrateDefaultRequest)
+
+	err := migrate.LiveMigrate(client.ServiceClient(), serverID, migrate.LiveMigrateOpts{}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,32 @@
+/*
+Package remoteconsoles provides functionality to open remote consoles to
+servers that have been provisioned by the OpenStack Compute service.
+
+Remote consoles are created with Create, which requires microversion 2.6 or
+later. Clouds that predate it expose one action per protocol instead, such as
+GetVNCConsole.
+
+Example to Create a Remote Console
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	createOpts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+
+	computeClient.Microversion = "2.6"
+	remoteConsole, err := remoteconsoles.Create(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Console URL: %s\n", remoteConsole.URL)
+
+Example to Get a VNC Console Before Microversion 2.6
+
+	remoteConsole, err := remoteconsoles.GetVNCConsole(computeClient, serverID, remoteconsoles.ConsoleTypeNoVNC).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package remoteconsoles
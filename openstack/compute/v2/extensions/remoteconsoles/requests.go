@@ -0,0 +1,123 @@
+package remoteconsoles
+
+import "github.com/gophercloud/gophercloud"
+
+// ConsoleProtocol represents the protocol of a remote console.
+type ConsoleProtocol string
+
+// ConsoleType represents the type of a remote console.
+type ConsoleType string
+
+const (
+	// ConsoleProtocolVNC represents the VNC console protocol.
+	ConsoleProtocolVNC ConsoleProtocol = "vnc"
+
+	// ConsoleProtocolSPICE represents the SPICE console protocol.
+	ConsoleProtocolSPICE ConsoleProtocol = "spice"
+
+	// ConsoleProtocolRDP represents the RDP console protocol.
+	ConsoleProtocolRDP ConsoleProtocol = "rdp"
+
+	// ConsoleProtocolSerial represents the Serial console protocol.
+	ConsoleProtocolSerial ConsoleProtocol = "serial"
+
+	// ConsoleProtocolMKS represents the MKS console protocol.
+	ConsoleProtocolMKS ConsoleProtocol = "mks"
+)
+
+const (
+	// ConsoleTypeNoVNC represents the VNC console type.
+	ConsoleTypeNoVNC ConsoleType = "novnc"
+
+	// ConsoleTypeXVPVNC represents the XVP VNC console type.
+	ConsoleTypeXVPVNC ConsoleType = "xvpvnc"
+
+	// ConsoleTypeRDPHTML5 represents the RDP HTML5 console type.
+	ConsoleTypeRDPHTML5 ConsoleType = "rdp-html5"
+
+	// ConsoleTypeSPICEHTML5 represents the SPICE HTML5 console type.
+	ConsoleTypeSPICEHTML5 ConsoleType = "spice-html5"
+
+	// ConsoleTypeSerial represents the Serial console type.
+	ConsoleTypeSerial ConsoleType = "serial"
+
+	// ConsoleTypeWebMKS represents the Web MKS console type.
+	ConsoleTypeWebMKS ConsoleType = "webmks"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToRemoteConsoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters to the Create request.
+type CreateOpts struct {
+	// Protocol specifies the protocol of a new remote console.
+	Protocol ConsoleProtocol `json:"protocol" required:"true"`
+
+	// Type specifies the type of a new remote console.
+	Type ConsoleType `json:"type" required:"true"`
+}
+
+// ToRemoteConsoleCreateMap builds a request body from the CreateOpts.
+func (opts CreateOpts) ToRemoteConsoleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "remote_console")
+}
+
+// Create requests the creation of a new remote console on the specified
+// server. It requires microversion 2.6 or later.
+func Create(client *gophercloud.ServiceClient, serverID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRemoteConsoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// getConsole issues one of the legacy console actions.
+func getConsole(client *gophercloud.ServiceClient, serverID, action string, protocol ConsoleProtocol, consoleType ConsoleType) (r GetResult) {
+	r.protocol = protocol
+	if consoleType == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "consoleType"
+		r.Err = err
+		return
+	}
+	b := map[string]interface{}{
+		action: map[string]interface{}{"type": consoleType},
+	}
+	_, r.Err = client.Post(actionURL(client, serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetVNCConsole retrieves a VNC console of the specified server. consoleType
+// is either ConsoleTypeNoVNC or ConsoleTypeXVPVNC. It was removed in
+// microversion 2.6.
+func GetVNCConsole(client *gophercloud.ServiceClient, serverID string, consoleType ConsoleType) (r GetResult) {
+	return getConsole(client, serverID, "os-getVNCConsole", ConsoleProtocolVNC, consoleType)
+}
+
+// GetSPICEConsole retrieves a SPICE console of the specified server.
+// consoleType is ConsoleTypeSPICEHTML5. It was removed in microversion 2.6.
+func GetSPICEConsole(client *gophercloud.ServiceClient, serverID string, consoleType ConsoleType) (r GetResult) {
+	return getConsole(client, serverID, "os-getSPICEConsole", ConsoleProtocolSPICE, consoleType)
+}
+
+// GetSerialConsole retrieves a serial console of the specified server.
+// consoleType is ConsoleTypeSerial. It was removed in microversion 2.6.
+func GetSerialConsole(client *gophercloud.ServiceClient, serverID string, consoleType ConsoleType) (r GetResult) {
+	return getConsole(client, serverID, "os-getSerialConsole", ConsoleProtocolSerial, consoleType)
+}
+
+// GetRDPConsole retrieves an RDP console of the specified server. consoleType
+// is ConsoleTypeRDPHTML5. It was removed in microversion 2.6.
+func GetRDPConsole(client *gophercloud.ServiceClient, serverID string, consoleType ConsoleType) (r GetResult) {
+	return getConsole(client, serverID, "os-getRDPConsole", ConsoleProtocolRDP, consoleType)
+}
@@ -0,0 +1,53 @@
+package remoteconsoles
+
+import "github.com/gophercloud/gophercloud"
+
+// RemoteConsole represents the Compute service remote console object.
+type RemoteConsole struct {
+	// Protocol contains remote console protocol.
+	Protocol ConsoleProtocol `json:"protocol"`
+
+	// Type contains remote console type.
+	Type ConsoleType `json:"type"`
+
+	// URL can be used to connect to the remote console.
+	URL string `json:"url"`
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a RemoteConsole.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any CreateResult as a RemoteConsole.
+func (r CreateResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		RemoteConsole *RemoteConsole `json:"remote_console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RemoteConsole, err
+}
+
+// GetResult represents the result of one of the legacy console actions, such
+// as GetVNCConsole. Call its Extract method to interpret it as a
+// RemoteConsole.
+type GetResult struct {
+	gophercloud.Result
+
+	// protocol is the protocol of the requested console, which the legacy
+	// actions do not return.
+	protocol ConsoleProtocol
+}
+
+// Extract interprets any GetResult as a RemoteConsole.
+func (r GetResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		Console *RemoteConsole `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	if err == nil && s.Console != nil {
+		s.Console.Protocol = r.protocol
+	}
+	return s.Console, err
+}
@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// CreateRequest is the body of a Create request.
+const CreateRequest = `
+{
+	"remote_console": {
+		"protocol": "vnc",
+		"type": "novnc"
+	}
+}
+`
+
+// CreateOutput is the body of a Create response.
+const CreateOutput = `
+{
+	"remote_console": {
+		"protocol": "vnc",
+		"type": "novnc",
+		"url": "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677"
+	}
+}
+`
+
+// GetSerialConsoleRequest is the body of a GetSerialConsole request.
+const GetSerialConsoleRequest = `
+{
+	"os-getSerialConsole": {
+		"type": "serial"
+	}
+}
+`
+
+// GetSerialConsoleOutput is the body of a GetSerialConsole response.
+const GetSerialConsoleOutput = `
+{
+	"console": {
+		"type": "serial",
+		"url": "ws://127.0.0.1:6083/?token=f9906a48-b71e-4f18-baca-c987da3ebdb3"
+	}
+}
+`
+
+// ExpectedCreatedConsole is the RemoteConsole in CreateOutput.
+var ExpectedCreatedConsole = remoteconsoles.RemoteConsole{
+	Protocol: remoteconsoles.ConsoleProtocolVNC,
+	Type:     remoteconsoles.ConsoleTypeNoVNC,
+	URL:      "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677",
+}
+
+// ExpectedSerialConsole is the RemoteConsole in GetSerialConsoleOutput.
+var ExpectedSerialConsole = remoteconsoles.RemoteConsole{
+	Protocol: remoteconsoles.ConsoleProtocolSerial,
+	Type:     remoteconsoles.ConsoleTypeSerial,
+	URL:      "ws://127.0.0.1:6083/?token=f9906a48-b71e-4f18-baca-c987da3ebdb3",
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T, serverID string) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/remote-consoles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, CreateOutput)
+	})
+}
+
+// HandleGetSerialConsoleSuccessfully configures the test server to respond to
+// a GetSerialConsole request.
+func HandleGetSerialConsoleSuccessfully(t *testing.T, serverID string) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, GetSerialConsoleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetSerialConsoleOutput)
+	})
+}
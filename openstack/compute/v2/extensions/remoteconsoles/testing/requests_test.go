@@ -0,0 +1,55 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateSuccessfully(t, serverID)
+
+	opts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+	actual, err := remoteconsoles.Create(client.ServiceClient(), serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedCreatedConsole, *actual)
+}
+
+func TestCreateMissingType(t *testing.T) {
+	opts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+	}
+	_, err := remoteconsoles.Create(client.ServiceClient(), serverID, opts).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestGetSerialConsole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetSerialConsoleSuccessfully(t, serverID)
+
+	actual, err := remoteconsoles.GetSerialConsole(client.ServiceClient(), serverID, remoteconsoles.ConsoleTypeSerial).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedSerialConsole, *actual)
+}
+
+func TestGetVNCConsoleMissingType(t *testing.T) {
+	_, err := remoteconsoles.GetVNCConsole(client.ServiceClient(), serverID, "").Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package resetnetwork provides functionality to reset the networking of
+servers that have been provisioned by the OpenStack Compute service. It is
+an administrative action, and only supported by the Xen driver.
+
+Example to Reset the Network of a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := resetnetwork.ResetNetwork(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package resetnetwork
@@ -0,0 +1,16 @@
+package resetnetwork
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ResetNetwork is the operation responsible for resetting the networking of
+// a Compute server.
+func ResetNetwork(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"resetNetwork": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
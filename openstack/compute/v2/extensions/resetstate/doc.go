@@ -0,0 +1,13 @@
+/*
+Package resetstate provides functionality to reset the state of servers that
+have been provisioned by the OpenStack Compute service.
+
+Example to Reset the State of a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := resetstate.ResetState(computeClient, serverID, resetstate.StateActive).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package resetstate
@@ -0,0 +1,30 @@
+package resetstate
+
+import "github.com/gophercloud/gophercloud"
+
+// ServerState refers to the states usable in ResetState Action
+type ServerState string
+
+const (
+	// StateActive returns the state of the server as active
+	StateActive ServerState = "active"
+
+	// StateError returns the state of the server as error
+	StateError ServerState = "error"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ResetState is the operation responsible for resetting the state of a
+// Compute server.
+func ResetState(client *gophercloud.ServiceClient, id string, state ServerState) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"os-resetState": map[string]interface{}{"state": state},
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,36 @@
+/*
+Package shelveunshelve provides functionality to shelve and unshelve servers
+that have been provisioned by the OpenStack Compute service. A shelved server
+is stopped and, once offloaded, releases its resources on the hypervisor.
+
+Example to Shelve, Offload and Unshelve a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := shelveunshelve.Shelve(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = shelveunshelve.ShelveOffload(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = shelveunshelve.Unshelve(computeClient, serverID, nil).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unshelve a Server into an Availability Zone
+
+	unshelveOpts := shelveunshelve.UnshelveOpts{
+		AvailabilityZone: "nova",
+	}
+
+	computeClient.Microversion = "2.77"
+	err := shelveunshelve.Unshelve(computeClient, serverID, unshelveOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package shelveunshelve
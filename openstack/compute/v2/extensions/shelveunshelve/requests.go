@@ -0,0 +1,65 @@
+package shelveunshelve
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Shelve is the operation responsible for shelving a Compute server.
+func Shelve(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelve": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// ShelveOffload is the operation responsible for offloading a shelved Compute
+// server from its hypervisor.
+func ShelveOffload(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelveOffload": nil}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// UnshelveOptsBuilder allows extensions to add additional parameters to the
+// Unshelve request.
+type UnshelveOptsBuilder interface {
+	ToServerUnshelveMap() (map[string]interface{}, error)
+}
+
+// UnshelveOpts represents the options used to unshelve a server.
+type UnshelveOpts struct {
+	// AvailabilityZone is the availability zone the server should be
+	// unshelved into. It requires microversion 2.77 or later.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToServerUnshelveMap formats an UnshelveOpts as a map that can be used as a
+// JSON request body for the Unshelve request.
+func (opts UnshelveOpts) ToServerUnshelveMap() (map[string]interface{}, error) {
+	// Nova rejects an empty unshelve object before microversion 2.77.
+	if opts.AvailabilityZone == "" {
+		return map[string]interface{}{"unshelve": nil}, nil
+	}
+	return gophercloud.BuildRequestBody(opts, "unshelve")
+}
+
+// Unshelve is the operation responsible for unshelving a Compute server. opts
+// may be nil.
+func Unshelve(client *gophercloud.ServiceClient, id string, opts UnshelveOptsBuilder) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{"unshelve": nil}
+	if opts != nil {
+		var err error
+		b, err = opts.ToServerUnshelveMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/shelveunshelve"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestShelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockActionResponse(t, serverID, `{"shelve": null}`)
+
+	err := shelveunshelve.Shelve(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestShelveOffload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockActionResponse(t, serverID, `{"shelveOffload": null}`)
+
+	err := shelveunshelve.ShelveOffload(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnshelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockActionResponse(t, serverID, `{"unshelve": null}`)
+
+	err := shelveunshelve.Unshelve(client.ServiceClient(), serverID, nil).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnshelveWithAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockActionResponse(t, serverID, `{"unshelve": {"availability_zone": "nova"}}`)
+
+	err := shelveunshelve.Unshelve(client.ServiceClient(), serverID, shelveunshelve.UnshelveOpts{
+		AvailabilityZone: "nova",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
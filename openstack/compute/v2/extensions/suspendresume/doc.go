@@ -0,0 +1,19 @@
+/*
+Package suspendresume provides functionality to suspend and resume servers
+that have been provisioned by the OpenStack Compute service. A suspended
+server has its memory saved to disk and releases its CPUs and memory.
+
+Example to Suspend and Resume a Server
+
+	serverID := "32c8baf7-1cdb-4cc2-bc31-c3a55b89f56b"
+	err := suspendresume.Suspend(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = suspendresume.Resume(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package suspendresume
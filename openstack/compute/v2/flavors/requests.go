@@ -2,6 +2,7 @@ package flavors
 
 import (
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -61,40 +62,29 @@ func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
 	return
 }
 
-// IDFromName is a convienience function that returns a flavor's ID given its name.
+// IDFromName is a convenience function that returns a flavor's ID given its name.
 func IDFromName(client *gophercloud.ServiceClient, name string) (string, error) {
-	count := 0
-	id := ""
-	allPages, err := ListDetail(client, nil).AllPages()
-	if err != nil {
-		return "", err
-	}
-
-	all, err := ExtractFlavors(allPages)
-	if err != nil {
-		return "", err
-	}
+	return utils.IDFromName(name, findOpts(client))
+}
 
-	for _, f := range all {
-		if f.Name == name {
-			count++
-			id = f.ID
-		}
-	}
+// FindID is a convenience function that returns a flavor's ID given either its
+// ID or its name.
+func FindID(client *gophercloud.ServiceClient, nameOrID string) (string, error) {
+	return utils.FindID(nameOrID, findOpts(client))
+}
 
-	switch count {
-	case 0:
-		err := &gophercloud.ErrResourceNotFound{}
-		err.ResourceType = "flavor"
-		err.Name = name
-		return "", err
-	case 1:
-		return id, nil
-	default:
-		err := &gophercloud.ErrMultipleResourcesFound{}
-		err.ResourceType = "flavor"
-		err.Name = name
-		err.Count = count
-		return "", err
+// findOpts describes how IDFromName and FindID look up a flavor.
+func findOpts(client *gophercloud.ServiceClient) utils.FindOpts {
+	return utils.FindOpts{
+		ResourceType:  "flavor",
+		Key:           "flavors",
+		PointerErrors: true,
+		Get: func(id string) error {
+			return Get(client, id).Err
+		},
+		List: func(name string) pagination.Pager {
+			// Nova has no name filter for flavors.
+			return ListDetail(client, nil)
+		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -132,3 +133,52 @@ func TestGetFlavor(t *testing.T) {
 		t.Errorf("Expected %#v, but was %#v", expected, actual)
 	}
 }
+
+func TestFlavorIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, `
+				{
+					"flavors": [
+						{"id": "1", "name": "m1.tiny"},
+						{"id": "2", "name": "m2.small"}
+					],
+					"flavors_links": [
+						{"href": "%s/flavors/detail?marker=2", "rel": "next"}
+					]
+				}
+			`, th.Server.URL)
+		case "2":
+			fmt.Fprintf(w, `{"flavors": [{"id": "3", "name": "m2.small"}]}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+
+	id, err := flavors.IDFromName(fake.ServiceClient(), "m1.tiny")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1", id)
+
+	_, err = flavors.IDFromName(fake.ServiceClient(), "m3.large")
+	if _, ok := err.(*gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected *gophercloud.ErrResourceNotFound, got %#v", err)
+	}
+
+	// A name shared by two flavors is reported rather than resolved to the
+	// first of them.
+	_, err = flavors.IDFromName(fake.ServiceClient(), "m2.small")
+	multiple, ok := err.(*gophercloud.ErrMultipleResourcesFound)
+	if !ok {
+		t.Fatalf("Expected *gophercloud.ErrMultipleResourcesFound, got %#v", err)
+	}
+	th.CheckDeepEquals(t, []string{"2", "3"}, multiple.IDs)
+}
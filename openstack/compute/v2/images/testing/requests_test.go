@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -187,3 +188,41 @@ func TestDeleteImage(t *testing.T) {
 	res := images.Delete(fake.ServiceClient(), "12345678")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestImageIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		switch name := r.Form.Get("name"); name {
+		case "cirros":
+			fmt.Fprintf(w, `
+				{
+					"images": [
+						{"id": "f90f6034-2570-4974-8351-6b49732ef2eb", "name": "cirros"},
+						{"id": "f3e4a95d-1f4f-4989-97ce-f3a1fb8c04d7", "name": "cirros"}
+					]
+				}
+			`)
+		default:
+			fmt.Fprintf(w, `{"images": []}`)
+		}
+	})
+
+	_, err := images.IDFromName(fake.ServiceClient(), "cirros")
+	multiple, ok := err.(*gophercloud.ErrMultipleResourcesFound)
+	if !ok {
+		t.Fatalf("Expected *gophercloud.ErrMultipleResourcesFound, got %#v", err)
+	}
+	th.CheckEquals(t, 2, multiple.Count)
+
+	_, err = images.IDFromName(fake.ServiceClient(), "fedora")
+	if _, ok := err.(*gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected *gophercloud.ErrResourceNotFound, got %#v", err)
+	}
+}
@@ -166,6 +166,21 @@ type Server struct {
 	SecurityGroups []map[string]interface{} `json:"security_groups"`
 }
 
+// State implements gophercloud.StatefulResource. Stopped, paused, suspended,
+// shelved and rescued servers are considered ready, as they remain in those
+// states until acted upon.
+func (r Server) State() (gophercloud.ResourceState, string) {
+	switch r.Status {
+	case "ACTIVE", "SHUTOFF", "PAUSED", "SUSPENDED", "SHELVED", "SHELVED_OFFLOADED", "RESCUE", "VERIFY_RESIZE":
+		return gophercloud.StateReady, r.Status
+	case "DELETED", "SOFT_DELETED":
+		return gophercloud.StateDeleted, r.Status
+	case "ERROR":
+		return gophercloud.StateFailed, r.Status
+	}
+	return gophercloud.StatePending, r.Status
+}
+
 func (s *Server) UnmarshalJSON(b []byte) error {
 	type tmp Server
 	var server *struct {
@@ -535,6 +535,28 @@ func HandleServerListSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleServerListByNameSuccessfully sets up the test server to respond to a
+// server List request that filters on the exact name "derp". Nova matches the
+// filter as a regular expression, so it responds with every server.
+func HandleServerListByNameSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		th.CheckEquals(t, "^derp$", r.Form.Get("name"))
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, ServerListBody)
+		case "9e5476bd-a4ec-4653-93d6-72c93aa682ba":
+			fmt.Fprintf(w, `{ "servers": [] }`)
+		default:
+			t.Fatalf("/servers/detail invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
 // HandleServerDeletionSuccessfully sets up the test server to respond to a server deletion request.
 func HandleServerDeletionSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/servers/asdfasdfasdf", func(w http.ResponseWriter, r *http.Request) {
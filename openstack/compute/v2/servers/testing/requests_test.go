@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -121,6 +122,34 @@ func TestGetServer(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, *actual)
 }
 
+func TestWaitUntilServerReady(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+
+	client := client.ServiceClient()
+	err := gophercloud.WaitUntilReady(5, func() (gophercloud.StatefulResource, error) {
+		return servers.Get(client, "1234asdf").Extract()
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestServerState(t *testing.T) {
+	states := map[string]gophercloud.ResourceState{
+		"BUILD":        gophercloud.StatePending,
+		"ACTIVE":       gophercloud.StateReady,
+		"SHUTOFF":      gophercloud.StateReady,
+		"HARD_REBOOT":  gophercloud.StatePending,
+		"ERROR":        gophercloud.StateFailed,
+		"SOFT_DELETED": gophercloud.StateDeleted,
+	}
+	for status, expected := range states {
+		state, actual := servers.Server{Status: status}.State()
+		th.CheckEquals(t, expected, state)
+		th.CheckEquals(t, status, actual)
+	}
+}
+
 func TestUpdateServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -411,3 +440,13 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListByNameSuccessfully(t)
+
+	id, err := servers.IDFromName(client.ServiceClient(), "derp")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "9e5476bd-a4ec-4653-93d6-72c93aa682ba", id)
+}
@@ -1,6 +1,8 @@
 package bays
 
 import (
+	"strings"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -70,6 +72,22 @@ type Bay struct {
 	ContainerVersion string `json:"container_version"`
 }
 
+// State implements gophercloud.StatefulResource. Bay statuses follow the
+// ACTION_OUTCOME format of the Heat stacks backing them.
+func (r Bay) State() (gophercloud.ResourceState, string) {
+	switch {
+	case r.Status == "DELETE_COMPLETE":
+		return gophercloud.StateDeleted, r.Status
+	case r.Status == "DELETE_FAILED":
+		return gophercloud.StateDeleteFailed, r.Status
+	case strings.HasSuffix(r.Status, "_FAILED"):
+		return gophercloud.StateFailed, r.Status
+	case strings.HasSuffix(r.Status, "_COMPLETE"):
+		return gophercloud.StateReady, r.Status
+	}
+	return gophercloud.StatePending, r.Status
+}
+
 // BayPage is the page returned by a pager when traversing over a
 // collection of bays.
 type BayPage struct {
@@ -56,6 +56,17 @@ type Instance struct {
 	Datastore datastores.DatastorePartial
 }
 
+// State implements gophercloud.StatefulResource.
+func (r Instance) State() (gophercloud.ResourceState, string) {
+	switch r.Status {
+	case "ACTIVE", "HEALTHY", "RESTART_REQUIRED":
+		return gophercloud.StateReady, r.Status
+	case "ERROR", "FAILED":
+		return gophercloud.StateFailed, r.Status
+	}
+	return gophercloud.StatePending, r.Status
+}
+
 type commonResult struct {
 	gophercloud.Result
 }
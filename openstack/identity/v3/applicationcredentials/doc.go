@@ -0,0 +1,35 @@
+/*
+Package applicationcredentials provides information and interaction with the
+application credentials API resource for the OpenStack Identity service.
+
+An application credential lets an application authenticate as a user, limited
+to the project and roles the credential was created with, without knowing the
+user's password. See the ApplicationCredentialID, ApplicationCredentialName and
+ApplicationCredentialSecret fields of gophercloud.AuthOptions to authenticate
+with one.
+
+Example to Create an Application Credential
+
+	expiresAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	createOpts := applicationcredentials.CreateOpts{
+		Name:      "ci",
+		Roles:     []applicationcredentials.Role{{Name: "member"}},
+		ExpiresAt: &expiresAt,
+	}
+
+	appCred, err := applicationcredentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The secret is only returned when the credential is created.
+	fmt.Println(appCred.ID, appCred.Secret)
+
+Example to Delete an Application Credential
+
+	err := applicationcredentials.Delete(identityClient, userID, appCredID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package applicationcredentials
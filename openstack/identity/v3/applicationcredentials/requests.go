@@ -0,0 +1,119 @@
+package applicationcredentials
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToApplicationCredentialListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// Name filters the application credentials by name.
+	Name string `q:"name"`
+}
+
+// ToApplicationCredentialListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToApplicationCredentialListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the application credentials of a user.
+func List(client *gophercloud.ServiceClient, userID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, userID)
+	if opts != nil {
+		query, err := opts.ToApplicationCredentialListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ApplicationCredentialPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves an application credential of a user, given its ID.
+func Get(client *gophercloud.ServiceClient, userID, id string) (r GetResult) {
+	_, r.Err = client.Get(applicationCredentialURL(client, userID, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToApplicationCredentialCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new application credential.
+type CreateOpts struct {
+	// Name is the name of the application credential. It must be unique among
+	// the application credentials of the user.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the application credential.
+	Description string `json:"description,omitempty"`
+
+	// Secret is the secret of the application credential. If it is omitted,
+	// the Identity service generates one and returns it in the response.
+	Secret string `json:"secret,omitempty"`
+
+	// Roles lists the roles the application credential delegates, each
+	// identified by ID or name. They must be a subset of the roles the user has
+	// on the project of the token used to create the credential. If omitted,
+	// all of those roles are delegated.
+	Roles []Role `json:"roles,omitempty"`
+
+	// ExpiresAt is the time at which the application credential expires. If
+	// nil, the application credential does not expire.
+	ExpiresAt *time.Time `json:"-"`
+
+	// Unrestricted allows the application credential to be used to create or
+	// delete other application credentials and trusts. Use with caution.
+	Unrestricted bool `json:"unrestricted,omitempty"`
+
+	// AccessRules restricts the API requests the application credential may
+	// be used for.
+	AccessRules []AccessRule `json:"access_rules,omitempty"`
+}
+
+// ToApplicationCredentialCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToApplicationCredentialCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "application_credential")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		b["application_credential"].(map[string]interface{})["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339MilliNoZ)
+	}
+
+	return b, nil
+}
+
+// Create creates a new application credential for a user. The credential is
+// bound to the project the client's token is scoped to.
+func Create(client *gophercloud.ServiceClient, userID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToApplicationCredentialCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client, userID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete deletes an application credential of a user.
+func Delete(client *gophercloud.ServiceClient, userID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(applicationCredentialURL(client, userID, id), nil)
+	return
+}
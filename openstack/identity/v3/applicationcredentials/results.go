@@ -0,0 +1,136 @@
+package applicationcredentials
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Role is a role delegated by an application credential.
+type Role struct {
+	// ID is the ID of the role.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the role.
+	Name string `json:"name,omitempty"`
+
+	// DomainID is the ID of the domain of a domain-specific role.
+	DomainID string `json:"domain_id,omitempty"`
+}
+
+// AccessRule restricts an application credential to API requests matching a
+// service, method and path.
+type AccessRule struct {
+	// ID is the ID of the access rule. It is assigned by the Identity service.
+	ID string `json:"id,omitempty"`
+
+	// Service is the type of the service the rule applies to, e.g. "compute".
+	Service string `json:"service,omitempty"`
+
+	// Method is the HTTP method the rule allows, e.g. "GET".
+	Method string `json:"method,omitempty"`
+
+	// Path is the API path the rule allows. It may contain the wildcards "*",
+	// matching one path segment, and "**", matching any number of segments.
+	Path string `json:"path,omitempty"`
+}
+
+// ApplicationCredential represents an application credential of a user.
+type ApplicationCredential struct {
+	// ID is the ID of the application credential.
+	ID string `json:"id"`
+
+	// Name is the name of the application credential.
+	Name string `json:"name"`
+
+	// Description is the description of the application credential.
+	Description string `json:"description"`
+
+	// Unrestricted indicates whether the application credential may be used
+	// to create or delete other application credentials and trusts.
+	Unrestricted bool `json:"unrestricted"`
+
+	// Secret is the secret of the application credential. It is only returned
+	// by Create.
+	Secret string `json:"secret"`
+
+	// ProjectID is the ID of the project the application credential is bound
+	// to.
+	ProjectID string `json:"project_id"`
+
+	// Roles lists the roles delegated by the application credential.
+	Roles []Role `json:"roles"`
+
+	// ExpiresAt is the time at which the application credential expires. It
+	// is the zero time if the application credential does not expire.
+	ExpiresAt gophercloud.JSONRFC3339MilliNoZ `json:"expires_at"`
+
+	// AccessRules lists the access rules of the application credential.
+	AccessRules []AccessRule `json:"access_rules"`
+
+	// Links contains referencing links to the application credential.
+	Links map[string]interface{} `json:"links"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as an ApplicationCredential.
+func (r commonResult) Extract() (*ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredential *ApplicationCredential `json:"application_credential"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ApplicationCredential, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an ApplicationCredential.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an ApplicationCredential.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ApplicationCredentialPage is a single page of ApplicationCredential results.
+type ApplicationCredentialPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an ApplicationCredentialPage contains any
+// results.
+func (r ApplicationCredentialPage) IsEmpty() (bool, error) {
+	applicationCredentials, err := ExtractApplicationCredentials(r)
+	return len(applicationCredentials) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ApplicationCredentialPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractApplicationCredentials returns a slice of ApplicationCredentials
+// contained in a single page of results.
+func ExtractApplicationCredentials(r pagination.Page) ([]ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredentials []ApplicationCredential `json:"application_credentials"`
+	}
+	err := (r.(ApplicationCredentialPage)).ExtractInto(&s)
+	return s.ApplicationCredentials, err
+}
@@ -0,0 +1,217 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// UserID is the ID of the user owning the application credentials.
+const UserID = "2844b2a08be147a08ef58317d6471f1f"
+
+// ListOutput provides a single page of ApplicationCredential results.
+const ListOutput = `
+{
+	"links": {
+		"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials",
+		"previous": null,
+		"next": null
+	},
+	"application_credentials": [
+		{
+			"links": {
+				"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+			},
+			"description": "CI pipelines",
+			"roles": [
+				{"domain_id": null, "name": "member", "id": "4494bc5bea1a4105ad7fbba6a7eb9ef4"}
+			],
+			"expires_at": null,
+			"unrestricted": false,
+			"access_rules": [],
+			"project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+			"id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+			"name": "ci"
+		},
+		{
+			"links": {
+				"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+			},
+			"description": null,
+			"roles": [
+				{"domain_id": null, "name": "reader", "id": "b4cf0d6b4e8f4ec8bb3eec4e3ad8de2b"}
+			],
+			"expires_at": "2027-01-01T00:00:00.000000",
+			"unrestricted": false,
+			"access_rules": [
+				{"id": "07d719df00f349ef8de77d542edf010c", "path": "/v2.1/servers", "method": "GET", "service": "compute"}
+			],
+			"project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+			"id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+			"name": "monitoring"
+		}
+	]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+	"application_credential": {
+		"links": {
+			"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+		},
+		"description": "CI pipelines",
+		"roles": [
+			{"domain_id": null, "name": "member", "id": "4494bc5bea1a4105ad7fbba6a7eb9ef4"}
+		],
+		"expires_at": null,
+		"unrestricted": false,
+		"access_rules": [],
+		"project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+		"id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+		"name": "ci"
+	}
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+	"application_credential": {
+		"name": "monitoring",
+		"roles": [
+			{"name": "reader"}
+		],
+		"expires_at": "2027-01-01T00:00:00",
+		"access_rules": [
+			{"path": "/v2.1/servers", "method": "GET", "service": "compute"}
+		]
+	}
+}
+`
+
+// CreateResponse provides the output of a Create request.
+const CreateResponse = `
+{
+	"application_credential": {
+		"links": {
+			"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+		},
+		"description": null,
+		"roles": [
+			{"domain_id": null, "name": "reader", "id": "b4cf0d6b4e8f4ec8bb3eec4e3ad8de2b"}
+		],
+		"expires_at": "2027-01-01T00:00:00.000000",
+		"unrestricted": false,
+		"secret": "JxE7Wc4GiPy-iSWqkZaVUo8AtPsqDkp4XpvhbOw7v_SGA1Ks",
+		"access_rules": [
+			{"id": "07d719df00f349ef8de77d542edf010c", "path": "/v2.1/servers", "method": "GET", "service": "compute"}
+		],
+		"project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+		"id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+		"name": "monitoring"
+	}
+}
+`
+
+// CIApplicationCredential is the unrestricted, non-expiring application
+// credential in the test fixtures.
+var CIApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:          "c4859fb437df4b87a51a8f5adcfb0bc7",
+	Name:        "ci",
+	Description: "CI pipelines",
+	ProjectID:   "53c2b94f63fb4f43a21b92d119ce549f",
+	Roles: []applicationcredentials.Role{
+		{ID: "4494bc5bea1a4105ad7fbba6a7eb9ef4", Name: "member"},
+	},
+	AccessRules: []applicationcredentials.AccessRule{},
+	Links: map[string]interface{}{
+		"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7",
+	},
+}
+
+// MonitoringApplicationCredential is the application credential with an
+// expiry and access rules in the test fixtures.
+var MonitoringApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:        "6b8cc7647da64166a4a3cc0c88ebbabb",
+	Name:      "monitoring",
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Roles: []applicationcredentials.Role{
+		{ID: "b4cf0d6b4e8f4ec8bb3eec4e3ad8de2b", Name: "reader"},
+	},
+	ExpiresAt: gophercloud.JSONRFC3339MilliNoZ(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)),
+	AccessRules: []applicationcredentials.AccessRule{
+		{ID: "07d719df00f349ef8de77d542edf010c", Path: "/v2.1/servers", Method: "GET", Service: "compute"},
+	},
+	Links: map[string]interface{}{
+		"self": "http://localhost:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb",
+	},
+}
+
+// ExpectedApplicationCredentialsSlice is the slice of application credentials
+// expected to be returned from ListOutput.
+var ExpectedApplicationCredentialsSlice = []applicationcredentials.ApplicationCredential{CIApplicationCredential, MonitoringApplicationCredential}
+
+// HandleListApplicationCredentialsSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials` on the test handler mux that
+// responds with a list of two application credentials.
+func HandleListApplicationCredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials/{id}` on the test handler mux
+// that responds with a single application credential.
+func HandleGetApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials` on the test handler mux that
+// tests application credential creation.
+func HandleCreateApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
+// HandleDeleteApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials/{id}` on the test handler mux
+// that tests application credential deletion.
+func HandleDeleteApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListApplicationCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListApplicationCredentialsSuccessfully(t)
+
+	count := 0
+	err := applicationcredentials.List(client.ServiceClient(), UserID, nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := applicationcredentials.ExtractApplicationCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedApplicationCredentialsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetApplicationCredentialSuccessfully(t)
+
+	actual, err := applicationcredentials.Get(client.ServiceClient(), UserID, "c4859fb437df4b87a51a8f5adcfb0bc7").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CIApplicationCredential, *actual)
+}
+
+func TestCreateApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateApplicationCredentialSuccessfully(t)
+
+	expiresAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	createOpts := applicationcredentials.CreateOpts{
+		Name:      "monitoring",
+		Roles:     []applicationcredentials.Role{{Name: "reader"}},
+		ExpiresAt: &expiresAt,
+		AccessRules: []applicationcredentials.AccessRule{
+			{Path: "/v2.1/servers", Method: "GET", Service: "compute"},
+		},
+	}
+
+	expected := MonitoringApplicationCredential
+	expected.Secret = "JxE7Wc4GiPy-iSWqkZaVUo8AtPsqDkp4XpvhbOw7v_SGA1Ks"
+
+	actual, err := applicationcredentials.Create(client.ServiceClient(), UserID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestCreateApplicationCredentialRequiresName(t *testing.T) {
+	res := applicationcredentials.Create(client.ServiceClient(), UserID, applicationcredentials.CreateOpts{})
+	if res.Err == nil {
+		t.Fatal("expected error but call succeeded")
+	}
+}
+
+func TestDeleteApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteApplicationCredentialSuccessfully(t)
+
+	res := applicationcredentials.Delete(client.ServiceClient(), UserID, "c4859fb437df4b87a51a8f5adcfb0bc7")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,11 @@
+package applicationcredentials
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient, userID string) string {
+	return client.ServiceURL("users", userID, "application_credentials")
+}
+
+func applicationCredentialURL(client *gophercloud.ServiceClient, userID, id string) string {
+	return client.ServiceURL("users", userID, "application_credentials", id)
+}
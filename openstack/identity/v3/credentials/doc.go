@@ -0,0 +1,62 @@
+/*
+Package credentials provides information and interaction with the credentials
+API resource for the OpenStack Identity service.
+
+Credentials are secrets, such as EC2 key pairs or TOTP seeds, that the
+Identity service stores on behalf of a user. The ec2credentials extension
+offers a more convenient interface to EC2 key pairs.
+
+Example to List Credentials
+
+	listOpts := credentials.ListOpts{
+		UserID: "bb5476",
+		Type:   "ec2",
+	}
+
+	allPages, err := credentials.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := credentials.ExtractCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		fmt.Printf("%+v\n", credential)
+	}
+
+Example to Create a Credential
+
+	createOpts := credentials.CreateOpts{
+		Blob:      `{"access": "181920", "secret": "secretKey"}`,
+		Type:      "ec2",
+		UserID:    "bb5476",
+		ProjectID: "731fc6",
+	}
+
+	credential, err := credentials.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Credential
+
+	updateOpts := credentials.UpdateOpts{
+		Blob: `{"access": "181920", "secret": "newSecretKey"}`,
+	}
+
+	credential, err := credentials.Update(identityClient, credentialID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Credential
+
+	err := credentials.Delete(identityClient, credentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package credentials
@@ -0,0 +1,134 @@
+package credentials
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToCredentialListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// UserID filters the credentials by the ID of the user owning them.
+	UserID string `q:"user_id"`
+
+	// Type filters the credentials by type, e.g. "ec2" or "totp".
+	Type string `q:"type"`
+}
+
+// ToCredentialListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToCredentialListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the credentials the client's token may access.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToCredentialListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return CredentialPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single credential, by ID.
+func Get(client *gophercloud.ServiceClient, credentialID string) (r GetResult) {
+	_, r.Err = client.Get(credentialURL(client, credentialID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToCredentialCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new credential.
+type CreateOpts struct {
+	// Blob is the serialized credential. For "ec2" credentials it is a JSON
+	// object with "access" and "secret" members.
+	Blob string `json:"blob" required:"true"`
+
+	// Type is the type of the credential, e.g. "ec2" or "totp".
+	Type string `json:"type" required:"true"`
+
+	// UserID is the ID of the user owning the credential.
+	UserID string `json:"user_id" required:"true"`
+
+	// ProjectID is the ID of the project the credential is bound to. It is
+	// required for "ec2" credentials.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToCredentialCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToCredentialCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "credential")
+}
+
+// Create creates a new credential.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToCredentialCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToCredentialUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a credential to update.
+type UpdateOpts struct {
+	// Blob is the new serialized credential.
+	Blob string `json:"blob,omitempty"`
+
+	// Type is the new type of the credential.
+	Type string `json:"type,omitempty"`
+
+	// UserID is the ID of the new owner of the credential.
+	UserID string `json:"user_id,omitempty"`
+
+	// ProjectID is the ID of the new project of the credential.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToCredentialUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToCredentialUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "credential")
+}
+
+// Update modifies the attributes of a credential.
+func Update(client *gophercloud.ServiceClient, credentialID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToCredentialUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(credentialURL(client, credentialID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a credential.
+func Delete(client *gophercloud.ServiceClient, credentialID string) (r DeleteResult) {
+	_, r.Err = client.Delete(credentialURL(client, credentialID), nil)
+	return
+}
@@ -0,0 +1,195 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/credentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Credential results.
+const ListOutput = `
+{
+    "credentials": [
+        {
+            "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+            "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+            "links": {
+                "self": "http://example.com/identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+            },
+            "project_id": "731fc6f265cd486d900f16e84c5cb594",
+            "type": "ec2",
+            "user_id": "bb5476fd12884539b41d5a88f838d773"
+        },
+        {
+            "blob": "GZSWBZSWO5EK2WQN",
+            "id": "2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609",
+            "links": {
+                "self": "http://example.com/identity/v3/credentials/2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609"
+            },
+            "project_id": "",
+            "type": "totp",
+            "user_id": "bb5476fd12884539b41d5a88f838d773"
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/credentials",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "credential": {
+        "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+        "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+        "links": {
+            "self": "http://example.com/identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+        },
+        "project_id": "731fc6f265cd486d900f16e84c5cb594",
+        "type": "ec2",
+        "user_id": "bb5476fd12884539b41d5a88f838d773"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "credential": {
+        "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+        "project_id": "731fc6f265cd486d900f16e84c5cb594",
+        "type": "ec2",
+        "user_id": "bb5476fd12884539b41d5a88f838d773"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "credential": {
+        "blob": "{\"access\":\"181920\",\"secret\":\"newSecretKey\"}"
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "credential": {
+        "blob": "{\"access\":\"181920\",\"secret\":\"newSecretKey\"}",
+        "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+        "links": {
+            "self": "http://example.com/identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+        },
+        "project_id": "731fc6f265cd486d900f16e84c5cb594",
+        "type": "ec2",
+        "user_id": "bb5476fd12884539b41d5a88f838d773"
+    }
+}
+`
+
+// EC2Credential is the ec2 Credential in the fixtures.
+var EC2Credential = credentials.Credential{
+	ID:        "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+	Blob:      `{"access":"181920","secret":"secretKey"}`,
+	Type:      "ec2",
+	UserID:    "bb5476fd12884539b41d5a88f838d773",
+	ProjectID: "731fc6f265cd486d900f16e84c5cb594",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+	},
+}
+
+// TOTPCredential is the totp Credential in the fixtures.
+var TOTPCredential = credentials.Credential{
+	ID:     "2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609",
+	Blob:   "GZSWBZSWO5EK2WQN",
+	Type:   "totp",
+	UserID: "bb5476fd12884539b41d5a88f838d773",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/credentials/2441494e52ab6d594a34d74586075cb299489bdd1e9389e3ab06467a4f460609",
+	},
+}
+
+// ExpectedCredentialsSlice is the slice of credentials expected to be
+// returned from ListOutput.
+var ExpectedCredentialsSlice = []credentials.Credential{EC2Credential, TOTPCredential}
+
+// HandleListCredentialsSuccessfully creates an HTTP handler at `/credentials`
+// on the test handler mux that responds with a list of two credentials.
+func HandleListCredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": "bb5476fd12884539b41d5a88f838d773"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetCredentialSuccessfully creates an HTTP handler at
+// `/credentials/3d3367...` on the test handler mux that responds with a
+// single credential.
+func HandleGetCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateCredentialSuccessfully creates an HTTP handler at
+// `/credentials` on the test handler mux that tests credential creation.
+func HandleCreateCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateCredentialSuccessfully creates an HTTP handler at
+// `/credentials/3d3367...` on the test handler mux that tests credential
+// updates.
+func HandleUpdateCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteCredentialSuccessfully creates an HTTP handler at
+// `/credentials/3d3367...` on the test handler mux that tests credential
+// deletion.
+func HandleDeleteCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/credentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListCredentialsSuccessfully(t)
+
+	count := 0
+	listOpts := credentials.ListOpts{UserID: "bb5476fd12884539b41d5a88f838d773"}
+	err := credentials.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := credentials.ExtractCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedCredentialsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetCredentialSuccessfully(t)
+
+	actual, err := credentials.Get(client.ServiceClient(), EC2Credential.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateCredentialSuccessfully(t)
+
+	createOpts := credentials.CreateOpts{
+		Blob:      `{"access":"181920","secret":"secretKey"}`,
+		Type:      "ec2",
+		UserID:    "bb5476fd12884539b41d5a88f838d773",
+		ProjectID: "731fc6f265cd486d900f16e84c5cb594",
+	}
+
+	actual, err := credentials.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateCredentialMissingBlob(t *testing.T) {
+	createOpts := credentials.CreateOpts{
+		Type:   "ec2",
+		UserID: "bb5476fd12884539b41d5a88f838d773",
+	}
+
+	_, err := createOpts.ToCredentialCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for a credential without a blob")
+	}
+}
+
+func TestUpdateCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateCredentialSuccessfully(t)
+
+	updateOpts := credentials.UpdateOpts{
+		Blob: `{"access":"181920","secret":"newSecretKey"}`,
+	}
+
+	actual, err := credentials.Update(client.ServiceClient(), EC2Credential.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, `{"access":"181920","secret":"newSecretKey"}`, actual.Blob)
+}
+
+func TestDeleteCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteCredentialSuccessfully(t)
+
+	res := credentials.Delete(client.ServiceClient(), EC2Credential.ID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,11 @@
+package credentials
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("credentials")
+}
+
+func credentialURL(client *gophercloud.ServiceClient, credentialID string) string {
+	return client.ServiceURL("credentials", credentialID)
+}
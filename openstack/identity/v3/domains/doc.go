@@ -0,0 +1,64 @@
+/*
+Package domains provides information and interaction with the domains API
+resource for the OpenStack Identity service.
+
+Example to List Domains
+
+	enabled := true
+	allPages, err := domains.List(identityClient, domains.ListOpts{Enabled: &enabled}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allDomains, err := domains.ExtractDomains(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Domain
+
+	domain, err := domains.Create(identityClient, domains.CreateOpts{Name: "customer-a"}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable and Delete a Domain
+
+	disabled := false
+	_, err := domains.Update(identityClient, domainID, domains.UpdateOpts{Enabled: &disabled}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = domains.Delete(identityClient, domainID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Back a Domain with LDAP
+
+	config := domains.Config{
+		domains.ConfigGroupIdentity: {
+			"driver": "ldap",
+		},
+		domains.ConfigGroupLDAP: {
+			"url":          "ldap://ldap.example.com",
+			"user_tree_dn": "ou=Users,dc=example,dc=com",
+		},
+	}
+
+	_, err := domains.CreateConfig(identityClient, domainID, config).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Read an LDAP Option
+
+	ldap, err := domains.GetConfigGroup(identityClient, domainID, domains.ConfigGroupLDAP).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	url, _ := ldap.String("url")
+*/
+package domains
@@ -0,0 +1,272 @@
+package domains
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToDomainListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// Name filters the domains by name.
+	Name string `q:"name"`
+
+	// Enabled, if set, filters the domains by whether they are enabled.
+	Enabled *bool
+}
+
+// ToDomainListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToDomainListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	// BuildQueryString omits false values, which are meaningful filters here.
+	params := q.Query()
+	if opts.Enabled != nil {
+		params.Set("enabled", strconv.FormatBool(*opts.Enabled))
+	}
+	q.RawQuery = params.Encode()
+
+	return q.String(), nil
+}
+
+// List enumerates the domains.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToDomainListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return DomainPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListAvailable enumerates the domains the user of the client's token may
+// scope a token to.
+func ListAvailable(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listAvailableURL(client), func(r pagination.PageResult) pagination.Page {
+		return DomainPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a domain, given its ID.
+func Get(client *gophercloud.ServiceClient, domainID string) (r GetResult) {
+	_, r.Err = client.Get(domainURL(client, domainID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToDomainCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new domain.
+type CreateOpts struct {
+	// Name is the name of the domain. It must be unique.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the domain.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets whether the domain is enabled. Domains are enabled by
+	// default.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToDomainCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToDomainCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "domain")
+}
+
+// Create creates a new domain.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToDomainCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToDomainUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a domain to update.
+type UpdateOpts struct {
+	// Name is the new name of the domain.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the domain. Set it to a pointer
+	// to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled enables or disables the domain. Disabling a domain disables
+	// its users and projects and invalidates their tokens.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToDomainUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToDomainUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "domain")
+}
+
+// Update modifies the attributes of a domain.
+func Update(client *gophercloud.ServiceClient, domainID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToDomainUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(domainURL(client, domainID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a domain. The domain must be disabled first.
+func Delete(client *gophercloud.ServiceClient, domainID string) (r DeleteResult) {
+	_, r.Err = client.Delete(domainURL(client, domainID), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a domain's ID given its
+// name. Domain names are unique, so the lookup stops at the first match.
+func IDFromName(client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.IDFromName(name, utils.FindOpts{
+		ResourceType: "domain",
+		Key:          "domains",
+		UniqueNames:  true,
+		List: func(name string) pagination.Pager {
+			return List(client, ListOpts{Name: name})
+		},
+	})
+}
+
+// ConfigOptsBuilder allows extensions to add additional parameters to
+// the CreateConfig and UpdateConfig requests.
+type ConfigOptsBuilder interface {
+	ToDomainConfigMap() (map[string]interface{}, error)
+}
+
+// ToDomainConfigMap formats a Config into a create or update request.
+func (c Config) ToDomainConfigMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"config": c}, nil
+}
+
+// GetConfig retrieves the domain-specific configuration of a domain.
+func GetConfig(client *gophercloud.ServiceClient, domainID string) (r ConfigResult) {
+	_, r.Err = client.Get(configURL(client, domainID), &r.Body, nil)
+	return
+}
+
+// CreateConfig sets the domain-specific configuration of a domain, replacing
+// any configuration it had.
+func CreateConfig(client *gophercloud.ServiceClient, domainID string, opts ConfigOptsBuilder) (r ConfigResult) {
+	b, err := opts.ToDomainConfigMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(configURL(client, domainID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// UpdateConfig merges the given groups and options into the domain-specific
+// configuration of a domain.
+func UpdateConfig(client *gophercloud.ServiceClient, domainID string, opts ConfigOptsBuilder) (r ConfigResult) {
+	b, err := opts.ToDomainConfigMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(configURL(client, domainID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteConfig deletes the domain-specific configuration of a domain.
+func DeleteConfig(client *gophercloud.ServiceClient, domainID string) (r DeleteResult) {
+	_, r.Err = client.Delete(configURL(client, domainID), nil)
+	return
+}
+
+// GetConfigGroup retrieves a single group, e.g. ConfigGroupLDAP, of the
+// domain-specific configuration of a domain.
+func GetConfigGroup(client *gophercloud.ServiceClient, domainID, group string) (r ConfigGroupResult) {
+	_, r.Err = client.Get(configGroupURL(client, domainID, group), &r.Body, nil)
+	r.group = group
+	return
+}
+
+// UpdateConfigGroup merges the given options into a group of the
+// domain-specific configuration of a domain.
+func UpdateConfigGroup(client *gophercloud.ServiceClient, domainID, group string, options ConfigGroup) (r ConfigResult) {
+	b := map[string]interface{}{"config": Config{group: options}}
+	_, r.Err = client.Patch(configGroupURL(client, domainID, group), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteConfigGroup deletes a group of the domain-specific configuration of
+// a domain.
+func DeleteConfigGroup(client *gophercloud.ServiceClient, domainID, group string) (r DeleteResult) {
+	_, r.Err = client.Delete(configGroupURL(client, domainID, group), nil)
+	return
+}
+
+// GetConfigOption retrieves a single option of the domain-specific
+// configuration of a domain.
+func GetConfigOption(client *gophercloud.ServiceClient, domainID, group, option string) (r ConfigOptionResult) {
+	_, r.Err = client.Get(configOptionURL(client, domainID, group, option), &r.Body, nil)
+	r.option = option
+	return
+}
+
+// UpdateConfigOption sets a single option of the domain-specific
+// configuration of a domain.
+func UpdateConfigOption(client *gophercloud.ServiceClient, domainID, group, option string, value interface{}) (r ConfigResult) {
+	b := map[string]interface{}{"config": map[string]interface{}{option: value}}
+	_, r.Err = client.Patch(configOptionURL(client, domainID, group, option), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteConfigOption deletes a single option of the domain-specific
+// configuration of a domain.
+func DeleteConfigOption(client *gophercloud.ServiceClient, domainID, group, option string) (r DeleteResult) {
+	_, r.Err = client.Delete(configOptionURL(client, domainID, group, option), nil)
+	return
+}
+
+// GetDefaultConfig retrieves the default values of the options that may be
+// set in domain-specific configurations.
+func GetDefaultConfig(client *gophercloud.ServiceClient) (r ConfigResult) {
+	_, r.Err = client.Get(defaultConfigURL(client), &r.Body, nil)
+	return
+}
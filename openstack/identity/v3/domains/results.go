@@ -0,0 +1,189 @@
+package domains
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Domain represents an OpenStack Identity domain.
+type Domain struct {
+	// ID is the unique ID of the domain.
+	ID string `json:"id"`
+
+	// Name is the name of the domain.
+	Name string `json:"name"`
+
+	// Description is the description of the domain.
+	Description string `json:"description"`
+
+	// Enabled is whether or not the domain is enabled.
+	Enabled bool `json:"enabled"`
+
+	// Links contains referencing links to the domain.
+	Links map[string]interface{} `json:"links"`
+}
+
+type domainResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Domain.
+func (r domainResult) Extract() (*Domain, error) {
+	var s struct {
+		Domain *Domain `json:"domain"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Domain, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Domain.
+type GetResult struct {
+	domainResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Domain.
+type CreateResult struct {
+	domainResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Domain.
+type UpdateResult struct {
+	domainResult
+}
+
+// DeleteResult is the response from a Delete, DeleteConfig,
+// DeleteConfigGroup or DeleteConfigOption operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// DomainPage is a single page of Domain results.
+type DomainPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a DomainPage contains any results.
+func (r DomainPage) IsEmpty() (bool, error) {
+	domains, err := ExtractDomains(r)
+	return len(domains) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r DomainPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractDomains returns a slice of Domains contained in a single page of
+// results.
+func ExtractDomains(r pagination.Page) ([]Domain, error) {
+	var s struct {
+		Domains []Domain `json:"domains"`
+	}
+	err := (r.(DomainPage)).ExtractInto(&s)
+	return s.Domains, err
+}
+
+// The groups of a domain-specific configuration. Only these groups may be
+// set for a domain.
+const (
+	ConfigGroupIdentity = "identity"
+	ConfigGroupLDAP     = "ldap"
+)
+
+// Config is the domain-specific identity configuration of a domain, keyed
+// by group.
+type Config map[string]ConfigGroup
+
+// ConfigGroup holds the options of a group of a domain-specific
+// configuration, keyed by option name, e.g. "url" or "user_tree_dn" for
+// ConfigGroupLDAP.
+type ConfigGroup map[string]interface{}
+
+// String returns the value of a string option, and whether the option is set
+// to a string.
+func (g ConfigGroup) String(option string) (string, bool) {
+	v, ok := g[option].(string)
+	return v, ok
+}
+
+// Bool returns the value of a boolean option, and whether the option is set
+// to a boolean.
+func (g ConfigGroup) Bool(option string) (bool, bool) {
+	v, ok := g[option].(bool)
+	return v, ok
+}
+
+// ConfigResult is the response from a GetConfig, CreateConfig,
+// UpdateConfig, UpdateConfigGroup, UpdateConfigOption or GetDefaultConfig
+// operation. Call its Extract method to interpret it as a Config.
+type ConfigResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ConfigResult as a Config.
+func (r ConfigResult) Extract() (Config, error) {
+	var s struct {
+		Config Config `json:"config"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Config, err
+}
+
+// ConfigGroupResult is the response from a GetConfigGroup operation. Call
+// its Extract method to interpret it as a ConfigGroup.
+type ConfigGroupResult struct {
+	gophercloud.Result
+	group string
+}
+
+// Extract interprets a ConfigGroupResult as the ConfigGroup that was
+// requested.
+func (r ConfigGroupResult) Extract() (ConfigGroup, error) {
+	var s struct {
+		Config Config `json:"config"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Config[r.group], err
+}
+
+// ConfigOptionResult is the response from a GetConfigOption operation. Call
+// its Extract or ExtractString method to interpret it.
+type ConfigOptionResult struct {
+	gophercloud.Result
+	option string
+}
+
+// Extract returns the value of the option that was requested.
+func (r ConfigOptionResult) Extract() (interface{}, error) {
+	var s struct {
+		Config ConfigGroup `json:"config"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Config[r.option], err
+}
+
+// ExtractString returns the value of the option that was requested, which
+// must be a string.
+func (r ConfigOptionResult) ExtractString() (string, error) {
+	v, err := r.Extract()
+	if err != nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", gophercloud.ErrUnexpectedType{Expected: "string", Actual: fmt.Sprintf("%T", v)}
+	}
+	return s, nil
+}
@@ -0,0 +1,378 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/domains"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Domain results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/domains"
+    },
+    "domains": [
+        {
+            "enabled": true,
+            "id": "2844b2",
+            "name": "dev-domain",
+            "links": {
+                "self": "http://example.com/identity/v3/domains/2844b2"
+            },
+            "description": "Domain of the developers"
+        },
+        {
+            "enabled": true,
+            "id": "default",
+            "links": {
+                "self": "http://example.com/identity/v3/domains/default"
+            },
+            "name": "Default",
+            "description": "Owns users and tenants (i.e. projects) available on Identity API v2."
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "domain": {
+        "enabled": true,
+        "id": "2844b2",
+        "name": "dev-domain",
+        "links": {
+            "self": "http://example.com/identity/v3/domains/2844b2"
+        },
+        "description": "Domain of the developers"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "domain": {
+        "name": "dev-domain",
+        "description": "Domain of the developers"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "domain": {
+        "enabled": false
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "domain": {
+        "enabled": false,
+        "id": "2844b2",
+        "name": "dev-domain",
+        "links": {
+            "self": "http://example.com/identity/v3/domains/2844b2"
+        },
+        "description": "Domain of the developers"
+    }
+}
+`
+
+// ConfigRequest provides the input to a CreateConfig request.
+const ConfigRequest = `
+{
+    "config": {
+        "identity": {
+            "driver": "ldap"
+        },
+        "ldap": {
+            "url": "ldap://ldap.example.com",
+            "user_tree_dn": "ou=Users,dc=example,dc=com"
+        }
+    }
+}
+`
+
+// ConfigOutput provides a GetConfig or CreateConfig result.
+const ConfigOutput = `
+{
+    "config": {
+        "identity": {
+            "driver": "ldap"
+        },
+        "ldap": {
+            "url": "ldap://ldap.example.com",
+            "user_tree_dn": "ou=Users,dc=example,dc=com",
+            "use_tls": true
+        }
+    }
+}
+`
+
+// ConfigGroupOutput provides a GetConfigGroup result.
+const ConfigGroupOutput = `
+{
+    "config": {
+        "ldap": {
+            "url": "ldap://ldap.example.com",
+            "user_tree_dn": "ou=Users,dc=example,dc=com",
+            "use_tls": true
+        }
+    }
+}
+`
+
+// UpdateConfigGroupRequest provides the input to an UpdateConfigGroup
+// request.
+const UpdateConfigGroupRequest = `
+{
+    "config": {
+        "ldap": {
+            "use_tls": true
+        }
+    }
+}
+`
+
+// ConfigOptionOutput provides a GetConfigOption result.
+const ConfigOptionOutput = `
+{
+    "config": {
+        "url": "ldap://ldap.example.com"
+    }
+}
+`
+
+// UpdateConfigOptionRequest provides the input to an UpdateConfigOption
+// request.
+const UpdateConfigOptionRequest = `
+{
+    "config": {
+        "url": "ldap://ldap.example.com"
+    }
+}
+`
+
+// FirstDomain is the first domain in the List request.
+var FirstDomain = domains.Domain{
+	Enabled: true,
+	ID:      "2844b2",
+	Name:    "dev-domain",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/domains/2844b2",
+	},
+	Description: "Domain of the developers",
+}
+
+// SecondDomain is the second domain in the List request.
+var SecondDomain = domains.Domain{
+	Enabled: true,
+	ID:      "default",
+	Name:    "Default",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/domains/default",
+	},
+	Description: "Owns users and tenants (i.e. projects) available on Identity API v2.",
+}
+
+// FirstDomainDisabled is how FirstDomain should look after it is disabled.
+var FirstDomainDisabled = domains.Domain{
+	Enabled: false,
+	ID:      "2844b2",
+	Name:    "dev-domain",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/domains/2844b2",
+	},
+	Description: "Domain of the developers",
+}
+
+// ExpectedDomainsSlice is the slice of domains expected to be returned from
+// ListOutput.
+var ExpectedDomainsSlice = []domains.Domain{FirstDomain, SecondDomain}
+
+// ExpectedLDAPConfig is the ldap group of ConfigOutput.
+var ExpectedLDAPConfig = domains.ConfigGroup{
+	"url":          "ldap://ldap.example.com",
+	"user_tree_dn": "ou=Users,dc=example,dc=com",
+	"use_tls":      true,
+}
+
+// ExpectedConfig is the Config of ConfigOutput.
+var ExpectedConfig = domains.Config{
+	domains.ConfigGroupIdentity: {"driver": "ldap"},
+	domains.ConfigGroupLDAP:     ExpectedLDAPConfig,
+}
+
+// HandleListDomainsSuccessfully creates an HTTP handler at `/domains` on the
+// test handler mux that responds with a list of two domains.
+func HandleListDomainsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleListAvailableDomainsSuccessfully creates an HTTP handler at
+// `/auth/domains` on the test handler mux that responds with a list of two
+// domains.
+func HandleListAvailableDomainsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetDomainSuccessfully creates an HTTP handler at `/domains/2844b2`
+// on the test handler mux that responds with a single domain.
+func HandleGetDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/2844b2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateDomainSuccessfully creates an HTTP handler at `/domains` on
+// the test handler mux that tests domain creation.
+func HandleCreateDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateDomainSuccessfully creates an HTTP handler at
+// `/domains/2844b2` on the test handler mux that tests disabling a domain.
+func HandleUpdateDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/2844b2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteDomainSuccessfully creates an HTTP handler at
+// `/domains/2844b2` on the test handler mux that tests domain deletion.
+func HandleDeleteDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/2844b2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleConfigSuccessfully creates HTTP handlers under
+// `/domains/2844b2/config` on the test handler mux that manage the
+// domain-specific configuration of the domain.
+func HandleConfigSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/2844b2/config", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ConfigOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, ConfigRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ConfigOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/domains/2844b2/config/ldap", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ConfigGroupOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateConfigGroupRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ConfigOutput)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/domains/2844b2/config/ldap/url", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ConfigOptionOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateConfigOptionRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ConfigOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleFindDomainSuccessfully creates an HTTP handler at `/domains` on the
+// test handler mux that responds with the domains matching the name filter.
+func HandleFindDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name": "Engineering",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"domains": [{"id": "2844b2", "name": "Engineering"}], "links": {"next": null}}`)
+	})
+}
@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/domains"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListDomains(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDomainsSuccessfully(t)
+
+	enabled := true
+	count := 0
+	err := domains.List(client.ServiceClient(), domains.ListOpts{Enabled: &enabled}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := domains.ExtractDomains(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedDomainsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListDomainsDisabled(t *testing.T) {
+	disabled := false
+	query, err := domains.ListOpts{Enabled: &disabled}.ToDomainListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?enabled=false", query)
+}
+
+func TestListAvailableDomains(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAvailableDomainsSuccessfully(t)
+
+	allPages, err := domains.ListAvailable(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := domains.ExtractDomains(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedDomainsSlice, actual)
+}
+
+func TestGetDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDomainSuccessfully(t)
+
+	actual, err := domains.Get(client.ServiceClient(), "2844b2").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstDomain, *actual)
+}
+
+func TestCreateDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateDomainSuccessfully(t)
+
+	createOpts := domains.CreateOpts{
+		Name:        "dev-domain",
+		Description: "Domain of the developers",
+	}
+
+	actual, err := domains.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstDomain, *actual)
+}
+
+func TestUpdateDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateDomainSuccessfully(t)
+
+	disabled := false
+	actual, err := domains.Update(client.ServiceClient(), "2844b2", domains.UpdateOpts{Enabled: &disabled}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstDomainDisabled, *actual)
+}
+
+func TestDeleteDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteDomainSuccessfully(t)
+
+	res := domains.Delete(client.ServiceClient(), "2844b2")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestConfig(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConfigSuccessfully(t)
+
+	config := domains.Config{
+		domains.ConfigGroupIdentity: {"driver": "ldap"},
+		domains.ConfigGroupLDAP: {
+			"url":          "ldap://ldap.example.com",
+			"user_tree_dn": "ou=Users,dc=example,dc=com",
+		},
+	}
+
+	actual, err := domains.CreateConfig(client.ServiceClient(), "2844b2", config).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedConfig, actual)
+
+	actual, err = domains.GetConfig(client.ServiceClient(), "2844b2").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedConfig, actual)
+
+	err = domains.DeleteConfig(client.ServiceClient(), "2844b2").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestConfigGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConfigSuccessfully(t)
+
+	ldap, err := domains.GetConfigGroup(client.ServiceClient(), "2844b2", domains.ConfigGroupLDAP).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedLDAPConfig, ldap)
+
+	url, ok := ldap.String("url")
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, "ldap://ldap.example.com", url)
+
+	useTLS, ok := ldap.Bool("use_tls")
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, true, useTLS)
+
+	_, ok = ldap.String("use_tls")
+	th.CheckEquals(t, false, ok)
+
+	actual, err := domains.UpdateConfigGroup(client.ServiceClient(), "2844b2", domains.ConfigGroupLDAP, domains.ConfigGroup{"use_tls": true}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedConfig, actual)
+}
+
+func TestConfigOption(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConfigSuccessfully(t)
+
+	url, err := domains.GetConfigOption(client.ServiceClient(), "2844b2", domains.ConfigGroupLDAP, "url").ExtractString()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "ldap://ldap.example.com", url)
+
+	actual, err := domains.UpdateConfigOption(client.ServiceClient(), "2844b2", domains.ConfigGroupLDAP, "url", "ldap://ldap.example.com").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedConfig, actual)
+
+	err = domains.DeleteConfigOption(client.ServiceClient(), "2844b2", domains.ConfigGroupLDAP, "url").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFindDomainSuccessfully(t)
+
+	id, err := domains.IDFromName(client.ServiceClient(), "Engineering")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2844b2", id)
+}
@@ -0,0 +1,31 @@
+package domains
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("domains")
+}
+
+func listAvailableURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("auth", "domains")
+}
+
+func domainURL(client *gophercloud.ServiceClient, domainID string) string {
+	return client.ServiceURL("domains", domainID)
+}
+
+func configURL(client *gophercloud.ServiceClient, domainID string) string {
+	return client.ServiceURL("domains", domainID, "config")
+}
+
+func configGroupURL(client *gophercloud.ServiceClient, domainID, group string) string {
+	return client.ServiceURL("domains", domainID, "config", group)
+}
+
+func configOptionURL(client *gophercloud.ServiceClient, domainID, group, option string) string {
+	return client.ServiceURL("domains", domainID, "config", group, option)
+}
+
+func defaultConfigURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("domains", "config", "default")
+}
@@ -14,6 +14,7 @@ type CreateOpts struct {
 	Availability gophercloud.Availability `json:"interface" required:"true"`
 	Name         string                   `json:"name" required:"true"`
 	Region       string                   `json:"region,omitempty"`
+	RegionID     string                   `json:"region_id,omitempty"`
 	URL          string                   `json:"url" required:"true"`
 	ServiceID    string                   `json:"service_id" required:"true"`
 }
@@ -43,6 +44,7 @@ type ListOptsBuilder interface {
 type ListOpts struct {
 	Availability gophercloud.Availability `q:"interface"`
 	ServiceID    string                   `q:"service_id"`
+	RegionID     string                   `q:"region_id"`
 	Page         int                      `q:"page"`
 	PerPage      int                      `q:"per_page"`
 }
@@ -67,6 +69,12 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// Get retrieves a single endpoint, given its ID.
+func Get(client *gophercloud.ServiceClient, endpointID string) (r GetResult) {
+	_, r.Err = client.Get(endpointURL(client, endpointID), &r.Body, nil)
+	return
+}
+
 type UpdateOptsBuilder interface {
 	ToEndpointUpdateMap() (map[string]interface{}, error)
 }
@@ -76,6 +84,7 @@ type UpdateOpts struct {
 	Availability gophercloud.Availability `json:"interface,omitempty"`
 	Name         string                   `json:"name,omitempty"`
 	Region       string                   `json:"region,omitempty"`
+	RegionID     string                   `json:"region_id,omitempty"`
 	URL          string                   `json:"url,omitempty"`
 	ServiceID    string                   `json:"service_id,omitempty"`
 }
@@ -29,6 +29,11 @@ func createErr(err error) CreateResult {
 	return CreateResult{commonResult{gophercloud.Result{Err: err}}}
 }
 
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
 // UpdateResult is the deferred result of an Update call.
 type UpdateResult struct {
 	commonResult
@@ -45,6 +50,7 @@ type Endpoint struct {
 	Availability gophercloud.Availability `json:"interface"`
 	Name         string                   `json:"name"`
 	Region       string                   `json:"region"`
+	RegionID     string                   `json:"region_id"`
 	ServiceID    string                   `json:"service_id"`
 	URL          string                   `json:"url"`
 }
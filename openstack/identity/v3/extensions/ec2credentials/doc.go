@@ -0,0 +1,45 @@
+/*
+Package ec2credentials provides information and interaction with the EC2
+credentials API resource (OS-EC2) for the OpenStack Identity service.
+
+EC2 credentials are access/secret key pairs used by EC2 and S3 compatible
+APIs. They can also be exchanged for a Keystone token with the ec2tokens
+package.
+
+Example to List EC2 Credentials
+
+	allPages, err := ec2credentials.List(identityClient, userID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := ec2credentials.ExtractCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		fmt.Printf("%+v\n", credential)
+	}
+
+Example to Create an EC2 Credential
+
+	createOpts := ec2credentials.CreateOpts{
+		TenantID: "6238dee2fec940a6bf31e49e9faf995a",
+	}
+
+	credential, err := ec2credentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(credential.Access, credential.Secret)
+
+Example to Delete an EC2 Credential
+
+	err := ec2credentials.Delete(identityClient, userID, access).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package ec2credentials
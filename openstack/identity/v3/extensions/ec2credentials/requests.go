@@ -0,0 +1,57 @@
+package ec2credentials
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List enumerates the EC2 credentials of a user.
+func List(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	return pagination.NewPager(client, rootURL(client, userID), func(r pagination.PageResult) pagination.Page {
+		return CredentialPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves an EC2 credential of a user, given its access key.
+func Get(client *gophercloud.ServiceClient, userID, access string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, userID, access), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToCredentialCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new EC2 credential.
+type CreateOpts struct {
+	// TenantID is the ID of the project tokens obtained with the credential
+	// are scoped to.
+	TenantID string `json:"tenant_id" required:"true"`
+}
+
+// ToCredentialCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToCredentialCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create generates a new EC2 key pair for a user. The Identity service
+// chooses both the access key and the secret.
+func Create(client *gophercloud.ServiceClient, userID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToCredentialCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, userID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete deletes an EC2 credential of a user, given its access key.
+func Delete(client *gophercloud.ServiceClient, userID, access string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, userID, access), nil)
+	return
+}
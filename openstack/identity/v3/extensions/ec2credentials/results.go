@@ -0,0 +1,93 @@
+package ec2credentials
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Credential represents an EC2 key pair of a user.
+type Credential struct {
+	// UserID is the ID of the user owning the credential.
+	UserID string `json:"user_id"`
+
+	// TenantID is the ID of the project tokens obtained with the credential
+	// are scoped to.
+	TenantID string `json:"tenant_id"`
+
+	// Access is the access key of the credential. It identifies the
+	// credential.
+	Access string `json:"access"`
+
+	// Secret is the secret key of the credential.
+	Secret string `json:"secret"`
+
+	// TrustID is the ID of the trust the credential was created with, if
+	// any.
+	TrustID string `json:"trust_id"`
+
+	// Links contains referencing links to the credential.
+	Links map[string]interface{} `json:"links"`
+}
+
+type credentialResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as a Credential.
+func (r credentialResult) Extract() (*Credential, error) {
+	var s struct {
+		Credential *Credential `json:"credential"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Credential, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Credential.
+type GetResult struct {
+	credentialResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Credential.
+type CreateResult struct {
+	credentialResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// CredentialPage is a single page of Credential results.
+type CredentialPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a CredentialPage contains any results.
+func (r CredentialPage) IsEmpty() (bool, error) {
+	credentials, err := ExtractCredentials(r)
+	return len(credentials) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r CredentialPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractCredentials returns a slice of Credentials contained in a single
+// page of results.
+func ExtractCredentials(r pagination.Page) ([]Credential, error) {
+	var s struct {
+		Credentials []Credential `json:"credentials"`
+	}
+	err := (r.(CredentialPage)).ExtractInto(&s)
+	return s.Credentials, err
+}
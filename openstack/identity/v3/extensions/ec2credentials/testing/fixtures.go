@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of EC2 Credential results.
+const ListOutput = `
+{
+    "credentials": [
+        {
+            "user_id": "2844b2a08be147a08ef58317d6471f1f",
+            "links": {
+                "self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae"
+            },
+            "tenant_id": "6238dee2fec940a6bf31e49e9faf995a",
+            "access": "f741662395b249c9b8acdebf1722c5ae",
+            "secret": "6a61eb0296034c89b49cc51dad9e6f56",
+            "trust_id": null
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// GetOutput provides a Get or Create result.
+const GetOutput = `
+{
+    "credential": {
+        "user_id": "2844b2a08be147a08ef58317d6471f1f",
+        "links": {
+            "self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae"
+        },
+        "tenant_id": "6238dee2fec940a6bf31e49e9faf995a",
+        "access": "f741662395b249c9b8acdebf1722c5ae",
+        "secret": "6a61eb0296034c89b49cc51dad9e6f56",
+        "trust_id": null
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "tenant_id": "6238dee2fec940a6bf31e49e9faf995a"
+}
+`
+
+// EC2Credential is the Credential in the fixtures.
+var EC2Credential = ec2credentials.Credential{
+	UserID:   "2844b2a08be147a08ef58317d6471f1f",
+	TenantID: "6238dee2fec940a6bf31e49e9faf995a",
+	Access:   "f741662395b249c9b8acdebf1722c5ae",
+	Secret:   "6a61eb0296034c89b49cc51dad9e6f56",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae",
+	},
+}
+
+// HandleListEC2CredentialsSuccessfully creates an HTTP handler at
+// `/users/2844b2.../credentials/OS-EC2` on the test handler mux that
+// responds with a list of EC2 credentials.
+func HandleListEC2CredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetEC2CredentialSuccessfully creates an HTTP handler at
+// `/users/2844b2.../credentials/OS-EC2/f74166...` on the test handler mux
+// that responds with a single EC2 credential.
+func HandleGetEC2CredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateEC2CredentialSuccessfully creates an HTTP handler at
+// `/users/2844b2.../credentials/OS-EC2` on the test handler mux that tests
+// EC2 credential creation.
+func HandleCreateEC2CredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleDeleteEC2CredentialSuccessfully creates an HTTP handler at
+// `/users/2844b2.../credentials/OS-EC2/f74166...` on the test handler mux
+// that tests EC2 credential deletion.
+func HandleDeleteEC2CredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/credentials/OS-EC2/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
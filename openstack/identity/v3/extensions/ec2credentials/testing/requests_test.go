@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2credentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListEC2Credentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEC2CredentialsSuccessfully(t)
+
+	count := 0
+	err := ec2credentials.List(client.ServiceClient(), EC2Credential.UserID).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := ec2credentials.ExtractCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, []ec2credentials.Credential{EC2Credential}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetEC2Credential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEC2CredentialSuccessfully(t)
+
+	actual, err := ec2credentials.Get(client.ServiceClient(), EC2Credential.UserID, EC2Credential.Access).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestCreateEC2Credential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateEC2CredentialSuccessfully(t)
+
+	createOpts := ec2credentials.CreateOpts{
+		TenantID: "6238dee2fec940a6bf31e49e9faf995a",
+	}
+
+	actual, err := ec2credentials.Create(client.ServiceClient(), EC2Credential.UserID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, EC2Credential, *actual)
+}
+
+func TestDeleteEC2Credential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteEC2CredentialSuccessfully(t)
+
+	res := ec2credentials.Delete(client.ServiceClient(), EC2Credential.UserID, EC2Credential.Access)
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,17 @@
+package ec2credentials
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	ExtPath        = "OS-EC2"
+	UserPath       = "users"
+	CredentialPath = "credentials"
+)
+
+func rootURL(c *gophercloud.ServiceClient, userID string) string {
+	return c.ServiceURL(UserPath, userID, CredentialPath, ExtPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, userID, access string) string {
+	return c.ServiceURL(UserPath, userID, CredentialPath, ExtPath, access)
+}
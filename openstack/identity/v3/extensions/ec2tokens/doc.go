@@ -0,0 +1,59 @@
+/*
+Package ec2tokens provides authentication with EC2 credentials for the
+OpenStack Identity service.
+
+The Identity service issues a token in exchange for a request signed with the
+secret of an EC2 credential (see the ec2credentials package), following the
+EC2 signature version 2 or 4 scheme. The token is scoped to the project of the
+credential.
+
+Example to Authenticate a ProviderClient with EC2 Credentials
+
+	authOptions := ec2tokens.AuthOptions{
+		Access:      "f741662395b249c9b8acdebf1722c5ae",
+		Secret:      "6a61eb0296034c89b49cc51dad9e6f56",
+		AllowReauth: true,
+	}
+
+	provider, err := openstack.NewClient("https://keystone.example.com/")
+	if err != nil {
+		panic(err)
+	}
+
+	err = openstack.AuthenticateV3EC2(provider, authOptions, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Obtain a Token with Signature Version 2
+
+	authOptions := ec2tokens.AuthOptions{
+		Access:           "f741662395b249c9b8acdebf1722c5ae",
+		Secret:           "6a61eb0296034c89b49cc51dad9e6f56",
+		SignatureVersion: 2,
+	}
+
+	token, err := ec2tokens.Create(identityClient, authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Validate a Request Signed by a Client
+
+	authOptions := ec2tokens.AuthOptions{
+		Access:    access,
+		Host:      r.Host,
+		Verb:      r.Method,
+		Path:      r.URL.Path,
+		Headers:   headers,
+		Params:    params,
+		BodyHash:  bodyHash,
+		Signature: signature,
+	}
+
+	token, err := ec2tokens.Create(identityClient, authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+*/
+package ec2tokens
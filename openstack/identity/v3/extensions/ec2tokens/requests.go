@@ -0,0 +1,353 @@
+package ec2tokens
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// The signature methods of EC2 signature version 2.
+const (
+	HmacSHA1   = "HmacSHA1"
+	HmacSHA256 = "HmacSHA256"
+)
+
+const (
+	// awsHmacV4 is the algorithm of EC2 signature version 4.
+	awsHmacV4 = "AWS4-HMAC-SHA256"
+
+	// awsRequestV4 terminates the credential scope of signature version 4.
+	awsRequestV4 = "aws4_request"
+
+	// timestampFormatV2, timestampFormatV4 and dateFormatV4 are the time
+	// formats of the signatures.
+	timestampFormatV2 = "2006-01-02T15:04:05Z"
+	timestampFormatV4 = "20060102T150405Z"
+	dateFormatV4      = "20060102"
+
+	// emptyBodyHash is the SHA-256 hash of an empty request body.
+	emptyBodyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// AuthOptionsBuilder allows extensions to add additional parameters to the
+// Create request.
+type AuthOptionsBuilder interface {
+	ToEC2TokenCreateMap() (map[string]interface{}, error)
+}
+
+// AuthOptions describes an EC2-style signed request that the Identity
+// service validates to issue a token. The token is scoped to the project of
+// the EC2 credential.
+//
+// Only Access and Secret are needed to authenticate: the request is signed
+// with EC2 signature version 4, or version 2 if SignatureVersion is 2, and
+// the remaining fields have sensible defaults. To have the Identity service
+// validate a request signed by someone else, e.g. by an S3 gateway, set
+// Signature and leave Secret empty; the request is then sent as it is.
+type AuthOptions struct {
+	// Access is the access key of the EC2 credential.
+	Access string `json:"access" required:"true"`
+
+	// Secret is the secret key of the EC2 credential. It is never sent to the
+	// Identity service, only used to sign the request.
+	Secret string `json:"-"`
+
+	// Host is the host the signed request is addressed to.
+	Host string `json:"host"`
+
+	// Verb is the method of the signed request. It defaults to "GET".
+	Verb string `json:"verb"`
+
+	// Path is the path of the signed request. It defaults to "/".
+	Path string `json:"path"`
+
+	// Params are the query parameters of the signed request.
+	Params map[string]string `json:"params"`
+
+	// Headers are the headers of the signed request. They are all signed
+	// with signature version 4 and ignored with version 2.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// BodyHash is the hex-encoded SHA-256 hash of the body of the signed
+	// request. It defaults to the hash of an empty body. It is only used by
+	// signature version 4.
+	BodyHash string `json:"body_hash,omitempty"`
+
+	// Signature is the signature of the request. It is computed from Secret
+	// when empty.
+	Signature string `json:"signature,omitempty"`
+
+	// SignatureVersion is the version of the EC2 signature, 2 or 4. It
+	// defaults to 4.
+	SignatureVersion int `json:"-"`
+
+	// SignatureMethod is the HMAC of signature version 2, HmacSHA256 (the
+	// default) or HmacSHA1.
+	SignatureMethod string `json:"-"`
+
+	// Region and Service make up the credential scope of signature version
+	// 4, with the date of Timestamp. They default to "RegionOne" and "ec2".
+	Region  string `json:"-"`
+	Service string `json:"-"`
+
+	// Timestamp is the time of the signed request. It defaults to the current
+	// time, which is what the Identity service expects: signatures older than
+	// a few minutes are rejected.
+	Timestamp time.Time `json:"-"`
+
+	// AllowReauth allows Gophercloud to re-authenticate automatically with a
+	// new signature if/when the token expires.
+	AllowReauth bool `json:"-"`
+}
+
+// ToEC2TokenCreateMap formats an AuthOptions into a create request, signing
+// it if needed.
+func (opts AuthOptions) ToEC2TokenCreateMap() (map[string]interface{}, error) {
+	if opts.Signature == "" {
+		if opts.Secret == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "Secret"}
+		}
+
+		var err error
+		switch opts.SignatureVersion {
+		case 0, 4:
+			opts, err = opts.signV4()
+		case 2:
+			opts, err = opts.signV2()
+		default:
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "SignatureVersion"
+			err.Value = opts.SignatureVersion
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.Params == nil {
+		opts.Params = map[string]string{}
+	}
+
+	return gophercloud.BuildRequestBody(opts, "credentials")
+}
+
+// withDefaults returns a copy of opts with the defaults of the signed request
+// filled in. The maps are copied so that signing does not modify the
+// caller's options.
+func (opts AuthOptions) withDefaults() AuthOptions {
+	if opts.Verb == "" {
+		opts.Verb = "GET"
+	}
+	if opts.Path == "" {
+		opts.Path = "/"
+	}
+	if opts.Timestamp.IsZero() {
+		opts.Timestamp = time.Now()
+	}
+	opts.Timestamp = opts.Timestamp.UTC()
+
+	params := make(map[string]string, len(opts.Params))
+	for k, v := range opts.Params {
+		params[k] = v
+	}
+	opts.Params = params
+
+	headers := make(map[string]string, len(opts.Headers))
+	for k, v := range opts.Headers {
+		headers[k] = v
+	}
+	opts.Headers = headers
+
+	return opts
+}
+
+// signV2 signs the request with EC2 signature version 2. The signature
+// parameters are added to Params, unless they are already present.
+func (opts AuthOptions) signV2() (AuthOptions, error) {
+	opts = opts.withDefaults()
+
+	method := opts.SignatureMethod
+	if method == "" {
+		method = HmacSHA256
+	}
+	var h func() hash.Hash
+	switch method {
+	case HmacSHA1:
+		h = sha1.New
+	case HmacSHA256:
+		h = sha256.New
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "SignatureMethod"
+		err.Value = method
+		return opts, err
+	}
+
+	setDefault(opts.Params, "AWSAccessKeyId", opts.Access)
+	setDefault(opts.Params, "SignatureMethod", method)
+	setDefault(opts.Params, "SignatureVersion", "2")
+	setDefault(opts.Params, "Timestamp", opts.Timestamp.Format(timestampFormatV2))
+	delete(opts.Params, "Signature")
+
+	stringToSign := strings.Join([]string{
+		opts.Verb,
+		opts.Host,
+		opts.Path,
+		canonicalQueryString(opts.Params),
+	}, "\n")
+	opts.Signature = base64.StdEncoding.EncodeToString(sum(h, []byte(opts.Secret), stringToSign))
+	opts.Headers = nil
+	opts.BodyHash = ""
+
+	return opts, nil
+}
+
+// signV4 signs the request with EC2 signature version 4. The signature is
+// carried, as for a real request, by the Authorization and X-Amz-Date
+// headers, which the Identity service parses to verify it.
+func (opts AuthOptions) signV4() (AuthOptions, error) {
+	opts = opts.withDefaults()
+
+	if opts.Region == "" {
+		opts.Region = "RegionOne"
+	}
+	if opts.Service == "" {
+		opts.Service = "ec2"
+	}
+	if opts.BodyHash == "" {
+		opts.BodyHash = emptyBodyHash
+	}
+	if opts.Host != "" && headerValue(opts.Headers, "Host") == "" {
+		opts.Headers["Host"] = opts.Host
+	}
+	delete(opts.Headers, "Authorization")
+	opts.Headers["X-Amz-Date"] = opts.Timestamp.Format(timestampFormatV4)
+
+	// All of the headers are signed.
+	canonicalHeaders := make(map[string]string, len(opts.Headers))
+	for k, v := range opts.Headers {
+		canonicalHeaders[strings.ToLower(strings.TrimSpace(k))] = strings.TrimSpace(v)
+	}
+	signedHeaders := make([]string, 0, len(canonicalHeaders))
+	for k := range canonicalHeaders {
+		signedHeaders = append(signedHeaders, k)
+	}
+	sort.Strings(signedHeaders)
+
+	var headerLines []string
+	for _, k := range signedHeaders {
+		headerLines = append(headerLines, k+":"+canonicalHeaders[k])
+	}
+
+	// POST requests carry their parameters in the body.
+	var query string
+	if opts.Verb != "POST" {
+		query = canonicalQueryString(opts.Params)
+	}
+
+	canonicalRequest := strings.Join([]string{
+		opts.Verb,
+		opts.Path,
+		query,
+		strings.Join(headerLines, "\n") + "\n",
+		strings.Join(signedHeaders, ";"),
+		opts.BodyHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	date := opts.Timestamp.Format(dateFormatV4)
+	scope := strings.Join([]string{date, opts.Region, opts.Service, awsRequestV4}, "/")
+	stringToSign := strings.Join([]string{
+		awsHmacV4,
+		opts.Timestamp.Format(timestampFormatV4),
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := sum(sha256.New, []byte("AWS4"+opts.Secret), date)
+	key = sum(sha256.New, key, opts.Region)
+	key = sum(sha256.New, key, opts.Service)
+	key = sum(sha256.New, key, awsRequestV4)
+	opts.Signature = hex.EncodeToString(sum(sha256.New, key, stringToSign))
+
+	opts.Headers["Authorization"] = fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsHmacV4, opts.Access, scope, strings.Join(signedHeaders, ";"), opts.Signature)
+
+	return opts, nil
+}
+
+// canonicalQueryString sorts and percent-encodes params the way both
+// signature versions expect: only unreserved characters are left as is.
+func canonicalQueryString(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, escape(k)+"="+escape(params[k]))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escape percent-encodes s, including spaces as "%20".
+func escape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+// sum returns the HMAC of data with key.
+func sum(h func() hash.Hash, key []byte, data string) []byte {
+	mac := hmac.New(h, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// setDefault sets params[key] to value if it is not set yet.
+func setDefault(params map[string]string, key, value string) {
+	if _, ok := params[key]; !ok {
+		params[key] = value
+	}
+}
+
+// headerValue returns the value of a header, ignoring the case of its name.
+func headerValue(headers map[string]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+// Create exchanges a signed EC2 request for a token. The Identity service
+// verifies the signature with the secret of the EC2 credential identified
+// by the access key. The client's token, if any, is not sent.
+func Create(client *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r tokens.CreateResult) {
+	b, err := opts.ToEC2TokenCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ec2tokensURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		OkCodes:     []int{200},
+	})
+	r.Err = err
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	return
+}
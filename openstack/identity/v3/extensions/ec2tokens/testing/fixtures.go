@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// TokenOutput provides the token issued for an EC2 credential.
+const TokenOutput = `
+{
+    "token": {
+        "methods": [
+            "ec2credential"
+        ],
+        "expires_at": "2026-10-19T11:04:39.000000Z",
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "6238dee2fec940a6bf31e49e9faf995a",
+            "name": "s3-users"
+        },
+        "catalog": [
+            {
+                "endpoints": [
+                    {
+                        "id": "39dc322ce86c4111b4f06c2eeae0841b",
+                        "interface": "public",
+                        "region": "RegionOne",
+                        "region_id": "RegionOne",
+                        "url": "https://object-store.example.com/v1/AUTH_6238dee2fec940a6bf31e49e9faf995a"
+                    }
+                ],
+                "id": "4363ae44bdf34a3981fde3b823cb9aa2",
+                "type": "object-store",
+                "name": "swift"
+            }
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "2844b2a08be147a08ef58317d6471f1f",
+            "name": "s3-user"
+        }
+    }
+}
+`
+
+// SignedV4Request is the ec2tokens request of a minimal AuthOptions signed
+// at 2026-10-18T23:04:39Z with signature version 4.
+const SignedV4Request = `
+{
+    "credentials": {
+        "access": "f741662395b249c9b8acdebf1722c5ae",
+        "body_hash": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+        "headers": {
+            "Authorization": "AWS4-HMAC-SHA256 Credential=f741662395b249c9b8acdebf1722c5ae/20261018/RegionOne/ec2/aws4_request, SignedHeaders=x-amz-date, Signature=%[1]s",
+            "X-Amz-Date": "20261018T230439Z"
+        },
+        "host": "",
+        "params": {},
+        "path": "/",
+        "signature": "%[1]s",
+        "verb": "GET"
+    }
+}
+`
+
+// SignedV4Signature is the signature of SignedV4Request.
+const SignedV4Signature = "a94cccdf53247a717b01ad5982865363c1238c179bbbe35346678ef6816397bc"
+
+// HandleEC2TokensSuccessfully creates an HTTP handler at `/ec2tokens` on the
+// test handler mux that checks the signed request and issues a token.
+func HandleEC2TokensSuccessfully(t *testing.T, request string) {
+	th.Mux.HandleFunc("/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "ec2-token")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, TokenOutput)
+	})
+}
@@ -0,0 +1,9 @@
+package ec2tokens
+
+import "github.com/gophercloud/gophercloud"
+
+const EC2TokensPath = "ec2tokens"
+
+func ec2tokensURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(EC2TokensPath)
+}
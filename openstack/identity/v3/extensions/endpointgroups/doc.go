@@ -0,0 +1,45 @@
+/*
+Package endpointgroups provides information and interaction with the
+project endpoint filtering API (OS-EP-FILTER) of the OpenStack Identity
+service.
+
+When endpoint filtering is enabled, the catalog of a project-scoped token
+only contains the endpoints associated with the project, either directly or
+through an endpoint group. An endpoint group selects endpoints by interface,
+service and region.
+
+Example to Create an Endpoint Group
+
+	createOpts := endpointgroups.CreateOpts{
+		Name: "public-compute",
+		Filters: endpointgroups.Filters{
+			Availability: gophercloud.AvailabilityPublic,
+			ServiceID:    "1b501a",
+		},
+	}
+
+	endpointGroup, err := endpointgroups.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add an Endpoint Group to the Catalog of a Project
+
+	err := endpointgroups.AddProject(identityClient, endpointGroupID, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Endpoints in the Catalog of a Project
+
+	allPages, err := endpointgroups.ListProjectEndpoints(identityClient, projectID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allEndpoints, err := endpoints.ExtractEndpoints(allPages)
+	if err != nil {
+		panic(err)
+	}
+*/
+package endpointgroups
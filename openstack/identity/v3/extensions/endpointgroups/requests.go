@@ -0,0 +1,223 @@
+package endpointgroups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToEndpointGroupListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// Name filters the endpoint groups by name.
+	Name string `q:"name"`
+}
+
+// ToEndpointGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToEndpointGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the endpoint groups.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToEndpointGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return EndpointGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves an endpoint group, given its ID.
+func Get(client *gophercloud.ServiceClient, endpointGroupID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, endpointGroupID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToEndpointGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new endpoint group.
+type CreateOpts struct {
+	// Name is the name of the endpoint group.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the endpoint group.
+	Description string `json:"description,omitempty"`
+
+	// Filters selects the endpoints of the group.
+	Filters Filters `json:"filters"`
+}
+
+// ToEndpointGroupCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToEndpointGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint_group")
+}
+
+// Create creates a new endpoint group.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToEndpointGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToEndpointGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of an endpoint group to update.
+type UpdateOpts struct {
+	// Name is the new name of the endpoint group.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the endpoint group. Set it to a
+	// pointer to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+
+	// Filters, if set, replaces the filters of the endpoint group.
+	Filters *Filters `json:"filters,omitempty"`
+}
+
+// ToEndpointGroupUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToEndpointGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint_group")
+}
+
+// Update modifies the attributes of an endpoint group.
+func Update(client *gophercloud.ServiceClient, endpointGroupID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToEndpointGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(resourceURL(client, endpointGroupID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an endpoint group.
+func Delete(client *gophercloud.ServiceClient, endpointGroupID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, endpointGroupID), nil)
+	return
+}
+
+// ListEndpoints enumerates the endpoints selected by the filters of an
+// endpoint group.
+func ListEndpoints(client *gophercloud.ServiceClient, endpointGroupID string) pagination.Pager {
+	return pagination.NewPager(client, listEndpointsURL(client, endpointGroupID), func(r pagination.PageResult) pagination.Page {
+		return endpoints.EndpointPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListProjects enumerates the projects associated with an endpoint group.
+func ListProjects(client *gophercloud.ServiceClient, endpointGroupID string) pagination.Pager {
+	return pagination.NewPager(client, listProjectsURL(client, endpointGroupID), func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AddProject associates an endpoint group with a project. The endpoints of
+// the group are added to the catalog of the project's tokens.
+func AddProject(client *gophercloud.ServiceClient, endpointGroupID, projectID string) (r AssociationResult) {
+	_, r.Err = client.Put(projectURL(client, endpointGroupID, projectID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CheckProject reports whether an endpoint group is associated with a
+// project.
+func CheckProject(client *gophercloud.ServiceClient, endpointGroupID, projectID string) (bool, error) {
+	resp, err := client.Head(projectURL(client, endpointGroupID, projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode != 404, nil
+}
+
+// RemoveProject removes the association of an endpoint group with a
+// project.
+func RemoveProject(client *gophercloud.ServiceClient, endpointGroupID, projectID string) (r AssociationResult) {
+	_, r.Err = client.Delete(projectURL(client, endpointGroupID, projectID), nil)
+	return
+}
+
+// ListForProject enumerates the endpoint groups associated with a project.
+func ListForProject(client *gophercloud.ServiceClient, projectID string) pagination.Pager {
+	return pagination.NewPager(client, listForProjectURL(client, projectID), func(r pagination.PageResult) pagination.Page {
+		return EndpointGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AddEndpointToProject associates a single endpoint with a project.
+func AddEndpointToProject(client *gophercloud.ServiceClient, projectID, endpointID string) (r AssociationResult) {
+	_, r.Err = client.Put(projectEndpointURL(client, projectID, endpointID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CheckEndpointInProject reports whether a single endpoint is associated
+// with a project. Endpoints associated through an endpoint group are not
+// considered.
+func CheckEndpointInProject(client *gophercloud.ServiceClient, projectID, endpointID string) (bool, error) {
+	resp, err := client.Head(projectEndpointURL(client, projectID, endpointID), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode != 404, nil
+}
+
+// RemoveEndpointFromProject removes the association of a single endpoint
+// with a project.
+func RemoveEndpointFromProject(client *gophercloud.ServiceClient, projectID, endpointID string) (r AssociationResult) {
+	_, r.Err = client.Delete(projectEndpointURL(client, projectID, endpointID), nil)
+	return
+}
+
+// ListProjectEndpoints enumerates the endpoints in the catalog of a
+// project, whether they are associated directly or through an endpoint
+// group.
+func ListProjectEndpoints(client *gophercloud.ServiceClient, projectID string) pagination.Pager {
+	return pagination.NewPager(client, listProjectEndpointsURL(client, projectID), func(r pagination.PageResult) pagination.Page {
+		return endpoints.EndpointPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListEndpointProjects enumerates the projects a single endpoint is
+// associated with.
+func ListEndpointProjects(client *gophercloud.ServiceClient, endpointID string) pagination.Pager {
+	return pagination.NewPager(client, listEndpointProjectsURL(client, endpointID), func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -0,0 +1,323 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/endpointgroups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of EndpointGroup results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups"
+    },
+    "endpoint_groups": [
+        {
+            "id": "ac4861",
+            "name": "public-compute",
+            "description": "Public compute endpoints",
+            "filters": {
+                "interface": "public",
+                "service_id": "1b501a"
+            },
+            "links": {
+                "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+            }
+        },
+        {
+            "id": "3de68c",
+            "name": "region-two",
+            "description": "",
+            "filters": {
+                "region_id": "RegionTwo"
+            },
+            "links": {
+                "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/3de68c"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "endpoint_group": {
+        "id": "ac4861",
+        "name": "public-compute",
+        "description": "Public compute endpoints",
+        "filters": {
+            "interface": "public",
+            "service_id": "1b501a"
+        },
+        "links": {
+            "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "endpoint_group": {
+        "name": "public-compute",
+        "description": "Public compute endpoints",
+        "filters": {
+            "interface": "public",
+            "service_id": "1b501a"
+        }
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "endpoint_group": {
+        "filters": {
+            "interface": "internal",
+            "service_id": "1b501a"
+        }
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "endpoint_group": {
+        "id": "ac4861",
+        "name": "public-compute",
+        "description": "Public compute endpoints",
+        "filters": {
+            "interface": "internal",
+            "service_id": "1b501a"
+        },
+        "links": {
+            "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+        }
+    }
+}
+`
+
+// ListEndpointsOutput provides the endpoints of an endpoint group.
+const ListEndpointsOutput = `
+{
+    "endpoints": [
+        {
+            "id": "6fedc0",
+            "interface": "public",
+            "region_id": "RegionOne",
+            "service_id": "1b501a",
+            "url": "https://compute.example.com/v2.1"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null
+    }
+}
+`
+
+// ListProjectsOutput provides the projects associated with an endpoint
+// group or endpoint.
+const ListProjectsOutput = `
+{
+    "projects": [
+        {
+            "domain_id": "default",
+            "enabled": true,
+            "id": "263fd9",
+            "name": "ci",
+            "parent_id": "default"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null
+    }
+}
+`
+
+// FirstEndpointGroup is the first endpoint group in the List request.
+var FirstEndpointGroup = endpointgroups.EndpointGroup{
+	ID:          "ac4861",
+	Name:        "public-compute",
+	Description: "Public compute endpoints",
+	Filters: endpointgroups.Filters{
+		Availability: gophercloud.AvailabilityPublic,
+		ServiceID:    "1b501a",
+	},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861",
+	},
+}
+
+// SecondEndpointGroup is the second endpoint group in the List request.
+var SecondEndpointGroup = endpointgroups.EndpointGroup{
+	ID:   "3de68c",
+	Name: "region-two",
+	Filters: endpointgroups.Filters{
+		RegionID: "RegionTwo",
+	},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/3de68c",
+	},
+}
+
+// FirstEndpointGroupUpdated is how FirstEndpointGroup should look after an
+// Update.
+var FirstEndpointGroupUpdated = endpointgroups.EndpointGroup{
+	ID:          "ac4861",
+	Name:        "public-compute",
+	Description: "Public compute endpoints",
+	Filters: endpointgroups.Filters{
+		Availability: gophercloud.AvailabilityInternal,
+		ServiceID:    "1b501a",
+	},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861",
+	},
+}
+
+// ExpectedEndpointGroupsSlice is the slice of endpoint groups expected to be
+// returned from ListOutput.
+var ExpectedEndpointGroupsSlice = []endpointgroups.EndpointGroup{FirstEndpointGroup, SecondEndpointGroup}
+
+// ExpectedEndpointsSlice is the slice of endpoints expected to be returned
+// from ListEndpointsOutput.
+var ExpectedEndpointsSlice = []endpoints.Endpoint{
+	{
+		ID:           "6fedc0",
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "RegionOne",
+		ServiceID:    "1b501a",
+		URL:          "https://compute.example.com/v2.1",
+	},
+}
+
+// ExpectedProjectsSlice is the slice of projects expected to be returned
+// from ListProjectsOutput.
+var ExpectedProjectsSlice = []projects.Project{
+	{
+		DomainID: "default",
+		Enabled:  true,
+		ID:       "263fd9",
+		Name:     "ci",
+		ParentID: "default",
+	},
+}
+
+// HandleListEndpointGroupsSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups` on the test handler mux that responds with
+// a list of two endpoint groups.
+func HandleListEndpointGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861` on the test handler mux that
+// responds with a single endpoint group.
+func HandleGetEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups` on the test handler mux that tests
+// endpoint group creation.
+func HandleCreateEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861` on the test handler mux that tests
+// endpoint group updates.
+func HandleUpdateEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861` on the test handler mux that tests
+// endpoint group deletion.
+func HandleDeleteEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at path on the test handler
+// mux that responds with output.
+func HandleListSuccessfully(t *testing.T, path, output string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandleAssociationSuccessfully creates an HTTP handler at path on the test
+// handler mux that adds, checks and removes an association. HEAD requests
+// are answered with headStatus.
+func HandleAssociationSuccessfully(t *testing.T, path string, headStatus int) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "HEAD":
+			w.WriteHeader(headStatus)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
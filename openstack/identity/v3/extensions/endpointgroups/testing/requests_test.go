@@ -0,0 +1,176 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/endpointgroups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListEndpointGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEndpointGroupsSuccessfully(t)
+
+	count := 0
+	err := endpointgroups.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := endpointgroups.ExtractEndpointGroups(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedEndpointGroupsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEndpointGroupSuccessfully(t)
+
+	actual, err := endpointgroups.Get(client.ServiceClient(), "ac4861").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstEndpointGroup, *actual)
+}
+
+func TestCreateEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateEndpointGroupSuccessfully(t)
+
+	createOpts := endpointgroups.CreateOpts{
+		Name:        "public-compute",
+		Description: "Public compute endpoints",
+		Filters: endpointgroups.Filters{
+			Availability: gophercloud.AvailabilityPublic,
+			ServiceID:    "1b501a",
+		},
+	}
+
+	actual, err := endpointgroups.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstEndpointGroup, *actual)
+}
+
+func TestUpdateEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateEndpointGroupSuccessfully(t)
+
+	updateOpts := endpointgroups.UpdateOpts{
+		Filters: &endpointgroups.Filters{
+			Availability: gophercloud.AvailabilityInternal,
+			ServiceID:    "1b501a",
+		},
+	}
+
+	actual, err := endpointgroups.Update(client.ServiceClient(), "ac4861", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstEndpointGroupUpdated, *actual)
+}
+
+func TestDeleteEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteEndpointGroupSuccessfully(t)
+
+	res := endpointgroups.Delete(client.ServiceClient(), "ac4861")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListEndpoints(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, "/OS-EP-FILTER/endpoint_groups/ac4861/endpoints", ListEndpointsOutput)
+	HandleListSuccessfully(t, "/OS-EP-FILTER/projects/263fd9/endpoints", ListEndpointsOutput)
+
+	allPages, err := endpointgroups.ListEndpoints(client.ServiceClient(), "ac4861").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := endpoints.ExtractEndpoints(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointsSlice, actual)
+
+	allPages, err = endpointgroups.ListProjectEndpoints(client.ServiceClient(), "263fd9").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err = endpoints.ExtractEndpoints(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointsSlice, actual)
+}
+
+func TestListProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, "/OS-EP-FILTER/endpoint_groups/ac4861/projects", ListProjectsOutput)
+	HandleListSuccessfully(t, "/OS-EP-FILTER/endpoints/6fedc0/projects", ListProjectsOutput)
+
+	allPages, err := endpointgroups.ListProjects(client.ServiceClient(), "ac4861").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectsSlice, actual)
+
+	allPages, err = endpointgroups.ListEndpointProjects(client.ServiceClient(), "6fedc0").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err = projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectsSlice, actual)
+}
+
+func TestListForProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, "/OS-EP-FILTER/projects/263fd9/endpoint_groups", ListOutput)
+
+	allPages, err := endpointgroups.ListForProject(client.ServiceClient(), "263fd9").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := endpointgroups.ExtractEndpointGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointGroupsSlice, actual)
+}
+
+func TestProjectAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAssociationSuccessfully(t, "/OS-EP-FILTER/endpoint_groups/ac4861/projects/263fd9", http.StatusOK)
+	HandleAssociationSuccessfully(t, "/OS-EP-FILTER/endpoint_groups/3de68c/projects/263fd9", http.StatusNotFound)
+
+	err := endpointgroups.AddProject(client.ServiceClient(), "ac4861", "263fd9").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := endpointgroups.CheckProject(client.ServiceClient(), "ac4861", "263fd9")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	ok, err = endpointgroups.CheckProject(client.ServiceClient(), "3de68c", "263fd9")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, ok)
+
+	err = endpointgroups.RemoveProject(client.ServiceClient(), "ac4861", "263fd9").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestEndpointAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAssociationSuccessfully(t, "/OS-EP-FILTER/projects/263fd9/endpoints/6fedc0", http.StatusNoContent)
+
+	err := endpointgroups.AddEndpointToProject(client.ServiceClient(), "263fd9", "6fedc0").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := endpointgroups.CheckEndpointInProject(client.ServiceClient(), "263fd9", "6fedc0")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	err = endpointgroups.RemoveEndpointFromProject(client.ServiceClient(), "263fd9", "6fedc0").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,46 @@
+package endpointgroups
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	ExtPath           = "OS-EP-FILTER"
+	EndpointGroupPath = "endpoint_groups"
+	EndpointPath      = "endpoints"
+	ProjectPath       = "projects"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, EndpointGroupPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, endpointGroupID string) string {
+	return c.ServiceURL(ExtPath, EndpointGroupPath, endpointGroupID)
+}
+
+func listEndpointsURL(c *gophercloud.ServiceClient, endpointGroupID string) string {
+	return c.ServiceURL(ExtPath, EndpointGroupPath, endpointGroupID, EndpointPath)
+}
+
+func listProjectsURL(c *gophercloud.ServiceClient, endpointGroupID string) string {
+	return c.ServiceURL(ExtPath, EndpointGroupPath, endpointGroupID, ProjectPath)
+}
+
+func projectURL(c *gophercloud.ServiceClient, endpointGroupID, projectID string) string {
+	return c.ServiceURL(ExtPath, EndpointGroupPath, endpointGroupID, ProjectPath, projectID)
+}
+
+func listForProjectURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(ExtPath, ProjectPath, projectID, EndpointGroupPath)
+}
+
+func listProjectEndpointsURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(ExtPath, ProjectPath, projectID, EndpointPath)
+}
+
+func projectEndpointURL(c *gophercloud.ServiceClient, projectID, endpointID string) string {
+	return c.ServiceURL(ExtPath, ProjectPath, projectID, EndpointPath, endpointID)
+}
+
+func listEndpointProjectsURL(c *gophercloud.ServiceClient, endpointID string) string {
+	return c.ServiceURL(ExtPath, EndpointPath, endpointID, ProjectPath)
+}
@@ -0,0 +1,78 @@
+/*
+Package federation provides information and interaction with the federation
+API (OS-FEDERATION) of the OpenStack Identity service: identity providers,
+their protocols, mappings and service providers. It also obtains federated
+tokens from OpenID Connect identity providers.
+
+Example to Register an OpenID Connect Identity Provider
+
+	enabled := true
+	idpOpts := federation.CreateIdentityProviderOpts{
+		Enabled:   &enabled,
+		RemoteIDs: []string{"https://idp.example.com"},
+	}
+
+	_, err := federation.CreateIdentityProvider(identityClient, "corp", idpOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	mappingOpts := federation.MappingOpts{
+		Rules: []federation.MappingRule{
+			{
+				Local: []federation.RuleLocal{
+					{User: &federation.RuleUser{Name: "{0}"}},
+					{Group: &federation.RuleGroup{ID: "0cd5e9"}},
+				},
+				Remote: []federation.RuleRemote{
+					{Type: "OIDC-preferred_username"},
+				},
+			},
+		},
+	}
+
+	_, err = federation.CreateMapping(identityClient, "corp-mapping", mappingOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	protocolOpts := federation.ProtocolOpts{MappingID: "corp-mapping"}
+	_, err = federation.CreateProtocol(identityClient, "corp", "openid", protocolOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate with an OpenID Connect Password and Rescope the Token
+
+	provider, err := openstack.NewClient("https://keystone.example.com/v3")
+	if err != nil {
+		panic(err)
+	}
+
+	identityClient, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+	authOpts := federation.OIDCAuthOptions{
+		IdentityProviderID: "corp",
+		ProtocolID:         "openid",
+		DiscoveryEndpoint:  "https://idp.example.com/.well-known/openid-configuration",
+		ClientID:           "keystone",
+		ClientSecret:       "secret",
+		Username:           "jdoe",
+		Password:           "password",
+	}
+
+	token, err := federation.AuthenticateOIDC(identityClient, authOpts).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
+	provider.TokenID = token.ID
+	err = openstack.RescopeV3(provider, &tokens.Scope{ProjectID: "263fd9"}, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+*/
+package federation
@@ -0,0 +1,29 @@
+package federation
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrOIDCTokenEndpointNotFound indicates that the OpenID Connect discovery
+// document of an identity provider does not advertise a token endpoint.
+type ErrOIDCTokenEndpointNotFound struct {
+	gophercloud.BaseError
+	DiscoveryEndpoint string
+}
+
+func (e ErrOIDCTokenEndpointNotFound) Error() string {
+	return fmt.Sprintf("No token_endpoint found in the OpenID Connect discovery document at %s", e.DiscoveryEndpoint)
+}
+
+// ErrOIDCTokenMissing indicates that the identity provider did not return the
+// kind of token to present to the Identity service.
+type ErrOIDCTokenMissing struct {
+	gophercloud.BaseError
+	TokenType string
+}
+
+func (e ErrOIDCTokenMissing) Error() string {
+	return fmt.Sprintf("The OpenID Connect identity provider did not return an %s", e.TokenType)
+}
@@ -0,0 +1,630 @@
+package federation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListIdentityProvidersOptsBuilder allows extensions to add additional
+// parameters to the ListIdentityProviders request.
+type ListIdentityProvidersOptsBuilder interface {
+	ToIdentityProviderListQuery() (string, error)
+}
+
+// ListIdentityProvidersOpts allows you to query the ListIdentityProviders
+// method.
+type ListIdentityProvidersOpts struct {
+	// ID filters the identity providers by ID.
+	ID string `q:"id"`
+
+	// Enabled, if set, filters the identity providers by whether they are
+	// enabled.
+	Enabled *bool
+}
+
+// ToIdentityProviderListQuery formats a ListIdentityProvidersOpts into a
+// query string.
+func (opts ListIdentityProvidersOpts) ToIdentityProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	// BuildQueryString omits false values, which are meaningful filters here.
+	params := q.Query()
+	if opts.Enabled != nil {
+		params.Set("enabled", strconv.FormatBool(*opts.Enabled))
+	}
+	q.RawQuery = params.Encode()
+
+	return q.String(), nil
+}
+
+// ListIdentityProviders enumerates the identity providers.
+func ListIdentityProviders(client *gophercloud.ServiceClient, opts ListIdentityProvidersOptsBuilder) pagination.Pager {
+	url := identityProvidersURL(client)
+	if opts != nil {
+		query, err := opts.ToIdentityProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return IdentityProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetIdentityProvider retrieves an identity provider, given its ID.
+func GetIdentityProvider(client *gophercloud.ServiceClient, idpID string) (r IdentityProviderResult) {
+	_, r.Err = client.Get(identityProviderURL(client, idpID), &r.Body, nil)
+	return
+}
+
+// CreateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateIdentityProvider request.
+type CreateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateIdentityProviderOpts specifies the attributes of a new identity
+// provider.
+type CreateIdentityProviderOpts struct {
+	// Description is a description of the identity provider.
+	Description string `json:"description,omitempty"`
+
+	// DomainID is the ID of the domain federated users are created in. The
+	// Identity service creates a domain for the identity provider if it is
+	// omitted.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Enabled sets whether users may authenticate with the identity
+	// provider. Identity providers are disabled by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs lists the identifiers the identity provider is known by,
+	// e.g. the entity ID of a SAML2 provider or the issuer of an OpenID
+	// Connect provider.
+	RemoteIDs []string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes group memberships obtained
+	// through the identity provider remain valid. If nil, the deployment's
+	// default applies.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderCreateMap formats a CreateIdentityProviderOpts into a
+// create request.
+func (opts CreateIdentityProviderOpts) ToIdentityProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// CreateIdentityProvider registers an identity provider under the given ID.
+func CreateIdentityProvider(client *gophercloud.ServiceClient, idpID string, opts CreateIdentityProviderOptsBuilder) (r IdentityProviderResult) {
+	b, err := opts.ToIdentityProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(identityProviderURL(client, idpID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateIdentityProvider request.
+type UpdateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateIdentityProviderOpts specifies the attributes of an identity
+// provider to update.
+type UpdateIdentityProviderOpts struct {
+	// Description is the new description of the identity provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled enables or disables the identity provider.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs, if set, replaces the identifiers of the identity provider.
+	RemoteIDs *[]string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the new lifetime, in minutes, of group memberships
+	// obtained through the identity provider.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderUpdateMap formats an UpdateIdentityProviderOpts into an
+// update request.
+func (opts UpdateIdentityProviderOpts) ToIdentityProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// UpdateIdentityProvider modifies the attributes of an identity provider.
+func UpdateIdentityProvider(client *gophercloud.ServiceClient, idpID string, opts UpdateIdentityProviderOptsBuilder) (r IdentityProviderResult) {
+	b, err := opts.ToIdentityProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(identityProviderURL(client, idpID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteIdentityProvider deletes an identity provider, along with its
+// protocols.
+func DeleteIdentityProvider(client *gophercloud.ServiceClient, idpID string) (r DeleteResult) {
+	_, r.Err = client.Delete(identityProviderURL(client, idpID), nil)
+	return
+}
+
+// ListProtocols enumerates the protocols of an identity provider.
+func ListProtocols(client *gophercloud.ServiceClient, idpID string) pagination.Pager {
+	return pagination.NewPager(client, protocolsURL(client, idpID), func(r pagination.PageResult) pagination.Page {
+		return ProtocolPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetProtocol retrieves a protocol of an identity provider.
+func GetProtocol(client *gophercloud.ServiceClient, idpID, protocolID string) (r ProtocolResult) {
+	_, r.Err = client.Get(protocolURL(client, idpID, protocolID), &r.Body, nil)
+	return
+}
+
+// ProtocolOptsBuilder allows extensions to add additional parameters to the
+// CreateProtocol and UpdateProtocol requests.
+type ProtocolOptsBuilder interface {
+	ToProtocolMap() (map[string]interface{}, error)
+}
+
+// ProtocolOpts specifies the attributes of a protocol.
+type ProtocolOpts struct {
+	// MappingID is the ID of the mapping applied to the assertions of the
+	// protocol. It is required when creating a protocol.
+	MappingID string `json:"mapping_id,omitempty"`
+
+	// RemoteIDAttribute is the attribute of the assertions that holds the
+	// identifier of the identity provider.
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolMap formats a ProtocolOpts into a create or update request.
+func (opts ProtocolOpts) ToProtocolMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// CreateProtocol adds a protocol, e.g. "openid" or "saml2", to an identity
+// provider.
+func CreateProtocol(client *gophercloud.ServiceClient, idpID, protocolID string, opts ProtocolOptsBuilder) (r ProtocolResult) {
+	b, err := opts.ToProtocolMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(protocolURL(client, idpID, protocolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateProtocol modifies a protocol of an identity provider.
+func UpdateProtocol(client *gophercloud.ServiceClient, idpID, protocolID string, opts ProtocolOptsBuilder) (r ProtocolResult) {
+	b, err := opts.ToProtocolMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(protocolURL(client, idpID, protocolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteProtocol deletes a protocol of an identity provider.
+func DeleteProtocol(client *gophercloud.ServiceClient, idpID, protocolID string) (r DeleteResult) {
+	_, r.Err = client.Delete(protocolURL(client, idpID, protocolID), nil)
+	return
+}
+
+// ListMappings enumerates the mappings.
+func ListMappings(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, mappingsURL(client), func(r pagination.PageResult) pagination.Page {
+		return MappingPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetMapping retrieves a mapping, given its ID.
+func GetMapping(client *gophercloud.ServiceClient, mappingID string) (r MappingResult) {
+	_, r.Err = client.Get(mappingURL(client, mappingID), &r.Body, nil)
+	return
+}
+
+// MappingOptsBuilder allows extensions to add additional parameters to the
+// CreateMapping and UpdateMapping requests.
+type MappingOptsBuilder interface {
+	ToMappingMap() (map[string]interface{}, error)
+}
+
+// MappingOpts specifies the rules of a mapping.
+type MappingOpts struct {
+	// Rules maps the attributes of federated users to local users, groups
+	// and projects.
+	Rules []MappingRule `json:"rules" required:"true"`
+}
+
+// ToMappingMap formats a MappingOpts into a create or update request.
+func (opts MappingOpts) ToMappingMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// CreateMapping creates a mapping under the given ID.
+func CreateMapping(client *gophercloud.ServiceClient, mappingID string, opts MappingOptsBuilder) (r MappingResult) {
+	b, err := opts.ToMappingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(mappingURL(client, mappingID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateMapping replaces the rules of a mapping.
+func UpdateMapping(client *gophercloud.ServiceClient, mappingID string, opts MappingOptsBuilder) (r MappingResult) {
+	b, err := opts.ToMappingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(mappingURL(client, mappingID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteMapping deletes a mapping.
+func DeleteMapping(client *gophercloud.ServiceClient, mappingID string) (r DeleteResult) {
+	_, r.Err = client.Delete(mappingURL(client, mappingID), nil)
+	return
+}
+
+// ListServiceProviders enumerates the service providers.
+func ListServiceProviders(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, serviceProvidersURL(client), func(r pagination.PageResult) pagination.Page {
+		return ServiceProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetServiceProvider retrieves a service provider, given its ID.
+func GetServiceProvider(client *gophercloud.ServiceClient, spID string) (r ServiceProviderResult) {
+	_, r.Err = client.Get(serviceProviderURL(client, spID), &r.Body, nil)
+	return
+}
+
+// CreateServiceProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateServiceProvider request.
+type CreateServiceProviderOptsBuilder interface {
+	ToServiceProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateServiceProviderOpts specifies the attributes of a new service
+// provider.
+type CreateServiceProviderOpts struct {
+	// AuthURL is the URL of the service provider's Identity service that
+	// accepts the SAML2 assertions of this Identity service.
+	AuthURL string `json:"auth_url" required:"true"`
+
+	// SPURL is the URL assertions are posted to, e.g. the ECP endpoint of
+	// the service provider.
+	SPURL string `json:"sp_url" required:"true"`
+
+	// Description is a description of the service provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets whether the service provider is enabled. Service
+	// providers are disabled by default.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML2
+	// messages sent to the service provider.
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderCreateMap formats a CreateServiceProviderOpts into a
+// create request.
+func (opts CreateServiceProviderOpts) ToServiceProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// CreateServiceProvider registers a service provider under the given ID.
+func CreateServiceProvider(client *gophercloud.ServiceClient, spID string, opts CreateServiceProviderOptsBuilder) (r ServiceProviderResult) {
+	b, err := opts.ToServiceProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(serviceProviderURL(client, spID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateServiceProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateServiceProvider request.
+type UpdateServiceProviderOptsBuilder interface {
+	ToServiceProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateServiceProviderOpts specifies the attributes of a service provider
+// to update.
+type UpdateServiceProviderOpts struct {
+	// AuthURL is the new URL of the service provider's Identity service.
+	AuthURL string `json:"auth_url,omitempty"`
+
+	// SPURL is the new URL assertions are posted to.
+	SPURL string `json:"sp_url,omitempty"`
+
+	// Description is the new description of the service provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled enables or disables the service provider.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the new prefix of the RelayState of SAML2
+	// messages.
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderUpdateMap formats an UpdateServiceProviderOpts into an
+// update request.
+func (opts UpdateServiceProviderOpts) ToServiceProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// UpdateServiceProvider modifies the attributes of a service provider.
+func UpdateServiceProvider(client *gophercloud.ServiceClient, spID string, opts UpdateServiceProviderOptsBuilder) (r ServiceProviderResult) {
+	b, err := opts.ToServiceProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(serviceProviderURL(client, spID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteServiceProvider deletes a service provider.
+func DeleteServiceProvider(client *gophercloud.ServiceClient, spID string) (r DeleteResult) {
+	_, r.Err = client.Delete(serviceProviderURL(client, spID), nil)
+	return
+}
+
+// The kinds of OpenID Connect tokens that can be exchanged for a federated
+// token.
+const (
+	OIDCAccessToken = "access_token"
+	OIDCIDToken     = "id_token"
+)
+
+// OIDCAuthOptions describes how to obtain a token from an OpenID Connect
+// identity provider and exchange it for an unscoped federated token. The
+// password grant is used when Username is set, like the v3oidcpassword
+// plugin of the OpenStack client, and the client credentials grant
+// otherwise, like v3oidcclientcredentials.
+type OIDCAuthOptions struct {
+	// IdentityProviderID is the ID of the identity provider in the Identity
+	// service.
+	IdentityProviderID string
+
+	// ProtocolID is the ID of the federation protocol of the identity
+	// provider, usually "openid".
+	ProtocolID string
+
+	// TokenEndpoint is the URL of the token endpoint of the identity
+	// provider.
+	TokenEndpoint string
+
+	// DiscoveryEndpoint is the URL of the OpenID Connect discovery document
+	// of the identity provider, e.g.
+	// https://idp.example.com/.well-known/openid-configuration. It is only
+	// used to find the token endpoint when TokenEndpoint is empty.
+	DiscoveryEndpoint string
+
+	// ClientID and ClientSecret are the credentials of the OpenID Connect
+	// client registered for the Identity service. ClientSecret may be empty
+	// for public clients.
+	ClientID     string
+	ClientSecret string
+
+	// Username and Password are the credentials of the user for the password
+	// grant.
+	Username string
+	Password string
+
+	// Scope lists the OpenID Connect scopes to request. It defaults to
+	// "openid".
+	Scope []string
+
+	// TokenType is the kind of token presented to the Identity service,
+	// OIDCAccessToken (the default) or OIDCIDToken.
+	TokenType string
+}
+
+// ToOIDCTokenRequest returns the form of the token request of opts.
+func (opts OIDCAuthOptions) ToOIDCTokenRequest() (url.Values, error) {
+	if opts.ClientID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "ClientID"}
+	}
+
+	scope := opts.Scope
+	if len(scope) == 0 {
+		scope = []string{"openid"}
+	}
+
+	form := url.Values{}
+	form.Set("scope", strings.Join(scope, " "))
+	if opts.Username != "" {
+		if opts.Password == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "Password"}
+		}
+		form.Set("grant_type", "password")
+		form.Set("username", opts.Username)
+		form.Set("password", opts.Password)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+	if opts.ClientSecret == "" {
+		// Public clients identify themselves in the form instead of with
+		// basic authentication.
+		form.Set("client_id", opts.ClientID)
+	}
+
+	return form, nil
+}
+
+// GetOIDCToken obtains a token from the OpenID Connect identity provider
+// described by opts. The identity provider is contacted directly: the
+// client's token, if any, is not sent.
+func GetOIDCToken(client *gophercloud.ProviderClient, opts OIDCAuthOptions) (r OIDCTokenResult) {
+	form, err := opts.ToOIDCTokenRequest()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	endpoint := opts.TokenEndpoint
+	if endpoint == "" {
+		if opts.DiscoveryEndpoint == "" {
+			r.Err = gophercloud.ErrMissingInput{Argument: "TokenEndpoint/DiscoveryEndpoint"}
+			return
+		}
+		var discovery struct {
+			TokenEndpoint string `json:"token_endpoint"`
+		}
+		req, err := http.NewRequest("GET", opts.DiscoveryEndpoint, nil)
+		if err != nil {
+			r.Err = err
+			return
+		}
+		if err := doOIDCRequest(client, req, &discovery); err != nil {
+			r.Err = err
+			return
+		}
+		if discovery.TokenEndpoint == "" {
+			r.Err = ErrOIDCTokenEndpointNotFound{DiscoveryEndpoint: opts.DiscoveryEndpoint}
+			return
+		}
+		endpoint = discovery.TokenEndpoint
+	}
+
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		r.Err = err
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if opts.ClientSecret != "" {
+		req.SetBasicAuth(opts.ClientID, opts.ClientSecret)
+	}
+	r.Err = doOIDCRequest(client, req, &r.Body)
+	return
+}
+
+// doOIDCRequest sends a request to an OpenID Connect identity provider and
+// decodes its JSON response into v.
+func doOIDCRequest(client *gophercloud.ProviderClient, req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", client.UserAgent.Join())
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return gophercloud.ErrUnexpectedResponseCode{
+			URL:      req.URL.String(),
+			Method:   req.Method,
+			Expected: []int{http.StatusOK},
+			Actual:   resp.StatusCode,
+			Body:     body,
+		}
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// AuthenticateOIDC obtains a token from the OpenID Connect identity provider
+// described by opts and exchanges it for an unscoped federated token. Call
+// ExtractToken on the result to get the token; it can then be rescoped to a
+// project or domain the federated user has access to with tokens.Create or
+// openstack.RescopeV3.
+func AuthenticateOIDC(client *gophercloud.ServiceClient, opts OIDCAuthOptions) (r tokens.CreateResult) {
+	if opts.IdentityProviderID == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "IdentityProviderID"}
+		return
+	}
+	if opts.ProtocolID == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "ProtocolID"}
+		return
+	}
+
+	oidcToken, err := GetOIDCToken(client.ProviderClient, opts).Extract()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	tokenType := opts.TokenType
+	if tokenType == "" {
+		tokenType = OIDCAccessToken
+	}
+	var bearer string
+	switch tokenType {
+	case OIDCAccessToken:
+		bearer = oidcToken.AccessToken
+	case OIDCIDToken:
+		bearer = oidcToken.IDToken
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "TokenType"
+		err.Value = opts.TokenType
+		r.Err = err
+		return
+	}
+	if bearer == "" {
+		r.Err = ErrOIDCTokenMissing{TokenType: tokenType}
+		return
+	}
+
+	resp, err := client.Post(federatedAuthURL(client, opts.IdentityProviderID, opts.ProtocolID), nil, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"X-Auth-Token":  "",
+			"Authorization": "Bearer " + bearer,
+		},
+		OkCodes: []int{201},
+	})
+	r.Err = err
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	return
+}
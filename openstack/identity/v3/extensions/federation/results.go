@@ -0,0 +1,389 @@
+package federation
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// IdentityProvider is an external source of identities, such as a SAML2 or
+// OpenID Connect provider, trusted by the Identity service.
+type IdentityProvider struct {
+	// ID is the ID of the identity provider.
+	ID string `json:"id"`
+
+	// Description is the description of the identity provider.
+	Description string `json:"description"`
+
+	// DomainID is the ID of the domain federated users are created in.
+	DomainID string `json:"domain_id"`
+
+	// Enabled is whether users may authenticate with the identity provider.
+	Enabled bool `json:"enabled"`
+
+	// RemoteIDs lists the identifiers the identity provider is known by.
+	RemoteIDs []string `json:"remote_ids"`
+
+	// AuthorizationTTL is the number of minutes group memberships obtained
+	// through the identity provider remain valid. It is nil when the
+	// deployment's default applies.
+	AuthorizationTTL *int `json:"authorization_ttl"`
+
+	// Links contains referencing links to the identity provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+// IdentityProviderResult is the response from a GetIdentityProvider,
+// CreateIdentityProvider or UpdateIdentityProvider operation. Call its
+// Extract method to interpret it as an IdentityProvider.
+type IdentityProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityProviderResult as an IdentityProvider.
+func (r IdentityProviderResult) Extract() (*IdentityProvider, error) {
+	var s struct {
+		IdentityProvider *IdentityProvider `json:"identity_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IdentityProvider, err
+}
+
+// IdentityProviderPage is a single page of IdentityProvider results.
+type IdentityProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an IdentityProviderPage contains any
+// results.
+func (r IdentityProviderPage) IsEmpty() (bool, error) {
+	idps, err := ExtractIdentityProviders(r)
+	return len(idps) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r IdentityProviderPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractIdentityProviders returns a slice of IdentityProviders contained in
+// a single page of results.
+func ExtractIdentityProviders(r pagination.Page) ([]IdentityProvider, error) {
+	var s struct {
+		IdentityProviders []IdentityProvider `json:"identity_providers"`
+	}
+	err := (r.(IdentityProviderPage)).ExtractInto(&s)
+	return s.IdentityProviders, err
+}
+
+// Protocol ties an identity provider to the mapping applied to the
+// assertions it issues with a federation protocol.
+type Protocol struct {
+	// ID is the ID of the protocol, e.g. "openid" or "saml2".
+	ID string `json:"id"`
+
+	// MappingID is the ID of the mapping applied to the assertions.
+	MappingID string `json:"mapping_id"`
+
+	// RemoteIDAttribute is the attribute of the assertions that holds the
+	// identifier of the identity provider.
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+
+	// Links contains referencing links to the protocol.
+	Links map[string]interface{} `json:"links"`
+}
+
+// ProtocolResult is the response from a GetProtocol, CreateProtocol or
+// UpdateProtocol operation. Call its Extract method to interpret it as a
+// Protocol.
+type ProtocolResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ProtocolResult as a Protocol.
+func (r ProtocolResult) Extract() (*Protocol, error) {
+	var s struct {
+		Protocol *Protocol `json:"protocol"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Protocol, err
+}
+
+// ProtocolPage is a single page of Protocol results.
+type ProtocolPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ProtocolPage contains any results.
+func (r ProtocolPage) IsEmpty() (bool, error) {
+	protocols, err := ExtractProtocols(r)
+	return len(protocols) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ProtocolPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractProtocols returns a slice of Protocols contained in a single page
+// of results.
+func ExtractProtocols(r pagination.Page) ([]Protocol, error) {
+	var s struct {
+		Protocols []Protocol `json:"protocols"`
+	}
+	err := (r.(ProtocolPage)).ExtractInto(&s)
+	return s.Protocols, err
+}
+
+// Mapping translates the attributes of federated users into local users,
+// group memberships and project roles.
+type Mapping struct {
+	// ID is the ID of the mapping.
+	ID string `json:"id"`
+
+	// Rules are the rules of the mapping.
+	Rules []MappingRule `json:"rules"`
+
+	// Links contains referencing links to the mapping.
+	Links map[string]interface{} `json:"links"`
+}
+
+// MappingRule applies its Local attributes to the federated users whose
+// assertions match all its Remote conditions.
+type MappingRule struct {
+	Local  []RuleLocal  `json:"local"`
+	Remote []RuleRemote `json:"remote"`
+}
+
+// RuleLocal is a local attribute of a mapping rule. Values may refer to the
+// remote attributes of the rule by position, e.g. "{0}".
+type RuleLocal struct {
+	// User identifies the local user.
+	User *RuleUser `json:"user,omitempty"`
+
+	// Group adds the user to a single group.
+	Group *RuleGroup `json:"group,omitempty"`
+
+	// Groups adds the user to the groups listed in a remote attribute,
+	// looked up by name in Domain.
+	Groups string `json:"groups,omitempty"`
+
+	// GroupIDs adds the user to the groups whose IDs are listed in a remote
+	// attribute.
+	GroupIDs string `json:"group_ids,omitempty"`
+
+	// Domain is the domain of the groups named by Groups.
+	Domain *RuleDomain `json:"domain,omitempty"`
+
+	// Projects creates projects for the user and grants it roles on them.
+	Projects []RuleProject `json:"projects,omitempty"`
+}
+
+// RuleUser identifies the local user of a mapping rule.
+type RuleUser struct {
+	ID     string      `json:"id,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Email  string      `json:"email,omitempty"`
+	Domain *RuleDomain `json:"domain,omitempty"`
+
+	// Type is "ephemeral" (the default) for users that only exist for the
+	// lifetime of their tokens, or "local" for existing users.
+	Type string `json:"type,omitempty"`
+}
+
+// RuleGroup identifies a group of a mapping rule, by ID or by name and
+// domain.
+type RuleGroup struct {
+	ID     string      `json:"id,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Domain *RuleDomain `json:"domain,omitempty"`
+}
+
+// RuleDomain identifies a domain of a mapping rule, by ID or by name.
+type RuleDomain struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RuleProject is a project of a mapping rule and the roles the user is
+// granted on it.
+type RuleProject struct {
+	Name  string     `json:"name"`
+	Roles []RuleRole `json:"roles"`
+}
+
+// RuleRole identifies a role of a mapping rule by name.
+type RuleRole struct {
+	Name string `json:"name"`
+}
+
+// RuleRemote is a condition on an attribute of the assertions of a
+// federated user. Without AnyOneOf, NotAnyOf, Whitelist or Blacklist, it
+// only requires the attribute to be present.
+type RuleRemote struct {
+	// Type is the name of the attribute, e.g. "OIDC-preferred_username".
+	Type string `json:"type"`
+
+	// AnyOneOf requires the attribute to have one of the values.
+	AnyOneOf []string `json:"any_one_of,omitempty"`
+
+	// NotAnyOf requires the attribute to have none of the values.
+	NotAnyOf []string `json:"not_any_of,omitempty"`
+
+	// Regex makes the values of AnyOneOf and NotAnyOf regular expressions.
+	Regex bool `json:"regex,omitempty"`
+
+	// Whitelist keeps only the listed values of the attribute.
+	Whitelist []string `json:"whitelist,omitempty"`
+
+	// Blacklist removes the listed values from the attribute.
+	Blacklist []string `json:"blacklist,omitempty"`
+}
+
+// MappingResult is the response from a GetMapping, CreateMapping or
+// UpdateMapping operation. Call its Extract method to interpret it as a
+// Mapping.
+type MappingResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a MappingResult as a Mapping.
+func (r MappingResult) Extract() (*Mapping, error) {
+	var s struct {
+		Mapping *Mapping `json:"mapping"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Mapping, err
+}
+
+// MappingPage is a single page of Mapping results.
+type MappingPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a MappingPage contains any results.
+func (r MappingPage) IsEmpty() (bool, error) {
+	mappings, err := ExtractMappings(r)
+	return len(mappings) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r MappingPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractMappings returns a slice of Mappings contained in a single page of
+// results.
+func ExtractMappings(r pagination.Page) ([]Mapping, error) {
+	var s struct {
+		Mappings []Mapping `json:"mappings"`
+	}
+	err := (r.(MappingPage)).ExtractInto(&s)
+	return s.Mappings, err
+}
+
+// ServiceProvider is a remote Identity service that accepts the SAML2
+// assertions of this Identity service.
+type ServiceProvider struct {
+	// ID is the ID of the service provider.
+	ID string `json:"id"`
+
+	// AuthURL is the URL of the service provider's Identity service.
+	AuthURL string `json:"auth_url"`
+
+	// SPURL is the URL assertions are posted to.
+	SPURL string `json:"sp_url"`
+
+	// Description is the description of the service provider.
+	Description string `json:"description"`
+
+	// Enabled is whether the service provider is enabled.
+	Enabled bool `json:"enabled"`
+
+	// RelayStatePrefix is the prefix of the RelayState of SAML2 messages.
+	RelayStatePrefix string `json:"relay_state_prefix"`
+
+	// Links contains referencing links to the service provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+// ServiceProviderResult is the response from a GetServiceProvider,
+// CreateServiceProvider or UpdateServiceProvider operation. Call its Extract
+// method to interpret it as a ServiceProvider.
+type ServiceProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ServiceProviderResult as a ServiceProvider.
+func (r ServiceProviderResult) Extract() (*ServiceProvider, error) {
+	var s struct {
+		ServiceProvider *ServiceProvider `json:"service_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceProvider, err
+}
+
+// ServiceProviderPage is a single page of ServiceProvider results.
+type ServiceProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ServiceProviderPage contains any
+// results.
+func (r ServiceProviderPage) IsEmpty() (bool, error) {
+	sps, err := ExtractServiceProviders(r)
+	return len(sps) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ServiceProviderPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractServiceProviders returns a slice of ServiceProviders contained in a
+// single page of results.
+func ExtractServiceProviders(r pagination.Page) ([]ServiceProvider, error) {
+	var s struct {
+		ServiceProviders []ServiceProvider `json:"service_providers"`
+	}
+	err := (r.(ServiceProviderPage)).ExtractInto(&s)
+	return s.ServiceProviders, err
+}
+
+func nextPageURL(r pagination.LinkedPageBase) (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// DeleteResult is the response from a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// OIDCToken is the response of the token endpoint of an OpenID Connect
+// identity provider.
+type OIDCToken struct {
+	AccessToken  string `json:"access_token"`
+	IDToken      string `json:"id_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// OIDCTokenResult is the response from a GetOIDCToken operation. Call its
+// Extract method to interpret it as an OIDCToken.
+type OIDCTokenResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an OIDCTokenResult as an OIDCToken.
+func (r OIDCTokenResult) Extract() (*OIDCToken, error) {
+	var s *OIDCToken
+	err := r.ExtractInto(&s)
+	return s, err
+}
@@ -0,0 +1,664 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListIdentityProvidersOutput provides a single page of IdentityProvider
+// results.
+const ListIdentityProvidersOutput = `
+{
+    "identity_providers": [
+        {
+            "authorization_ttl": 60,
+            "description": "Corporate OpenID Connect provider",
+            "domain_id": "ad8bc5",
+            "enabled": true,
+            "id": "corp",
+            "remote_ids": [
+                "https://idp.example.com"
+            ],
+            "links": {
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers"
+    }
+}
+`
+
+// IdentityProviderOutput provides a GetIdentityProvider or
+// CreateIdentityProvider result.
+const IdentityProviderOutput = `
+{
+    "identity_provider": {
+        "authorization_ttl": 60,
+        "description": "Corporate OpenID Connect provider",
+        "domain_id": "ad8bc5",
+        "enabled": true,
+        "id": "corp",
+        "remote_ids": [
+            "https://idp.example.com"
+        ],
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp"
+        }
+    }
+}
+`
+
+// CreateIdentityProviderRequest provides the input to a
+// CreateIdentityProvider request.
+const CreateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "authorization_ttl": 60,
+        "description": "Corporate OpenID Connect provider",
+        "domain_id": "ad8bc5",
+        "enabled": true,
+        "remote_ids": [
+            "https://idp.example.com"
+        ]
+    }
+}
+`
+
+// UpdateIdentityProviderRequest provides the input to an
+// UpdateIdentityProvider request.
+const UpdateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "enabled": false
+    }
+}
+`
+
+// UpdateIdentityProviderOutput provides an UpdateIdentityProvider result.
+const UpdateIdentityProviderOutput = `
+{
+    "identity_provider": {
+        "authorization_ttl": 60,
+        "description": "Corporate OpenID Connect provider",
+        "domain_id": "ad8bc5",
+        "enabled": false,
+        "id": "corp",
+        "remote_ids": [
+            "https://idp.example.com"
+        ],
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp"
+        }
+    }
+}
+`
+
+// ListProtocolsOutput provides a single page of Protocol results.
+const ListProtocolsOutput = `
+{
+    "protocols": [
+        {
+            "id": "openid",
+            "mapping_id": "corp-mapping",
+            "remote_id_attribute": "HTTP_OIDC_ISS",
+            "links": {
+                "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null
+    }
+}
+`
+
+// ProtocolOutput provides a GetProtocol, CreateProtocol or UpdateProtocol
+// result.
+const ProtocolOutput = `
+{
+    "protocol": {
+        "id": "openid",
+        "mapping_id": "corp-mapping",
+        "remote_id_attribute": "HTTP_OIDC_ISS",
+        "links": {
+            "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid"
+        }
+    }
+}
+`
+
+// ProtocolRequest provides the input to a CreateProtocol or UpdateProtocol
+// request.
+const ProtocolRequest = `
+{
+    "protocol": {
+        "mapping_id": "corp-mapping",
+        "remote_id_attribute": "HTTP_OIDC_ISS"
+    }
+}
+`
+
+// ListMappingsOutput provides a single page of Mapping results.
+const ListMappingsOutput = `
+{
+    "mappings": [
+        {
+            "id": "corp-mapping",
+            "rules": [
+                {
+                    "local": [
+                        {
+                            "user": {
+                                "name": "{0}"
+                            }
+                        },
+                        {
+                            "groups": "{1}",
+                            "domain": {
+                                "id": "ad8bc5"
+                            }
+                        }
+                    ],
+                    "remote": [
+                        {
+                            "type": "OIDC-preferred_username"
+                        },
+                        {
+                            "type": "OIDC-groups",
+                            "whitelist": [
+                                "developers",
+                                "operators"
+                            ]
+                        }
+                    ]
+                }
+            ],
+            "links": {
+                "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null
+    }
+}
+`
+
+// MappingOutput provides a GetMapping, CreateMapping or UpdateMapping
+// result.
+const MappingOutput = `
+{
+    "mapping": {
+        "id": "corp-mapping",
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}"
+                        }
+                    },
+                    {
+                        "groups": "{1}",
+                        "domain": {
+                            "id": "ad8bc5"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-groups",
+                        "whitelist": [
+                            "developers",
+                            "operators"
+                        ]
+                    }
+                ]
+            }
+        ],
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping"
+        }
+    }
+}
+`
+
+// MappingRequest provides the input to a CreateMapping or UpdateMapping
+// request.
+const MappingRequest = `
+{
+    "mapping": {
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}"
+                        }
+                    },
+                    {
+                        "groups": "{1}",
+                        "domain": {
+                            "id": "ad8bc5"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-groups",
+                        "whitelist": [
+                            "developers",
+                            "operators"
+                        ]
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// ListServiceProvidersOutput provides a single page of ServiceProvider
+// results.
+const ListServiceProvidersOutput = `
+{
+    "service_providers": [
+        {
+            "auth_url": "https://keystone.partner.example.com/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+            "description": "Partner cloud",
+            "enabled": true,
+            "id": "partner",
+            "relay_state_prefix": "ss:mem:",
+            "sp_url": "https://keystone.partner.example.com/Shibboleth.sso/SAML2/ECP",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/partner"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null
+    }
+}
+`
+
+// ServiceProviderOutput provides a GetServiceProvider,
+// CreateServiceProvider or UpdateServiceProvider result.
+const ServiceProviderOutput = `
+{
+    "service_provider": {
+        "auth_url": "https://keystone.partner.example.com/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Partner cloud",
+        "enabled": true,
+        "id": "partner",
+        "relay_state_prefix": "ss:mem:",
+        "sp_url": "https://keystone.partner.example.com/Shibboleth.sso/SAML2/ECP",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/partner"
+        }
+    }
+}
+`
+
+// CreateServiceProviderRequest provides the input to a
+// CreateServiceProvider request.
+const CreateServiceProviderRequest = `
+{
+    "service_provider": {
+        "auth_url": "https://keystone.partner.example.com/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Partner cloud",
+        "enabled": true,
+        "sp_url": "https://keystone.partner.example.com/Shibboleth.sso/SAML2/ECP"
+    }
+}
+`
+
+// FederatedTokenOutput provides the unscoped token issued for a federated
+// user.
+const FederatedTokenOutput = `
+{
+    "token": {
+        "methods": [
+            "openid"
+        ],
+        "user": {
+            "domain": {
+                "id": "Federated",
+                "name": "Federated"
+            },
+            "id": "ef6c5b",
+            "name": "jdoe",
+            "OS-FEDERATION": {
+                "identity_provider": {
+                    "id": "corp"
+                },
+                "protocol": {
+                    "id": "openid"
+                },
+                "groups": [
+                    {
+                        "id": "0cd5e9"
+                    }
+                ]
+            }
+        },
+        "expires_at": "2026-10-19T11:04:39.000000Z",
+        "issued_at": "2026-10-18T23:04:39.000000Z"
+    }
+}
+`
+
+// sixty is the AuthorizationTTL of the identity provider fixtures.
+var sixty = 60
+
+// yes is the Enabled value of the fixtures.
+var yes = true
+
+// ExpectedIdentityProvider is the IdentityProvider of the fixtures.
+var ExpectedIdentityProvider = federation.IdentityProvider{
+	AuthorizationTTL: &sixty,
+	Description:      "Corporate OpenID Connect provider",
+	DomainID:         "ad8bc5",
+	Enabled:          true,
+	ID:               "corp",
+	RemoteIDs:        []string{"https://idp.example.com"},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+	},
+}
+
+// ExpectedProtocol is the Protocol of the fixtures.
+var ExpectedProtocol = federation.Protocol{
+	ID:                "openid",
+	MappingID:         "corp-mapping",
+	RemoteIDAttribute: "HTTP_OIDC_ISS",
+	Links: map[string]interface{}{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid",
+	},
+}
+
+// ExpectedMappingRules are the rules of the mapping of the fixtures.
+var ExpectedMappingRules = []federation.MappingRule{
+	{
+		Local: []federation.RuleLocal{
+			{User: &federation.RuleUser{Name: "{0}"}},
+			{Groups: "{1}", Domain: &federation.RuleDomain{ID: "ad8bc5"}},
+		},
+		Remote: []federation.RuleRemote{
+			{Type: "OIDC-preferred_username"},
+			{Type: "OIDC-groups", Whitelist: []string{"developers", "operators"}},
+		},
+	},
+}
+
+// ExpectedMapping is the Mapping of the fixtures.
+var ExpectedMapping = federation.Mapping{
+	ID:    "corp-mapping",
+	Rules: ExpectedMappingRules,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping",
+	},
+}
+
+// ExpectedServiceProvider is the ServiceProvider of the fixtures.
+var ExpectedServiceProvider = federation.ServiceProvider{
+	AuthURL:          "https://keystone.partner.example.com/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+	Description:      "Partner cloud",
+	Enabled:          true,
+	ID:               "partner",
+	RelayStatePrefix: "ss:mem:",
+	SPURL:            "https://keystone.partner.example.com/Shibboleth.sso/SAML2/ECP",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/partner",
+	},
+}
+
+// HandleListIdentityProvidersSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers` on the test handler mux that responds
+// with a list of identity providers.
+func HandleListIdentityProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListIdentityProvidersOutput)
+	})
+}
+
+// HandleIdentityProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp` on the test handler mux that
+// gets, creates, updates and deletes the identity provider.
+func HandleIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, IdentityProviderOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateIdentityProviderRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, IdentityProviderOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateIdentityProviderRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, UpdateIdentityProviderOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListProtocolsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols` on the test handler mux
+// that responds with a list of protocols.
+func HandleListProtocolsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListProtocolsOutput)
+	})
+}
+
+// HandleProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid` on the test
+// handler mux that gets, creates, updates and deletes the protocol.
+func HandleProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ProtocolOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, ProtocolRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ProtocolOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, ProtocolRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ProtocolOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListMappingsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings` on the test handler mux that responds with a
+// list of mappings.
+func HandleListMappingsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListMappingsOutput)
+	})
+}
+
+// HandleMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/corp-mapping` on the test handler mux that gets,
+// creates, updates and deletes the mapping.
+func HandleMappingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/corp-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, MappingOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, MappingRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, MappingOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, MappingRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, MappingOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListServiceProvidersSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/service_providers` on the test handler mux that responds
+// with a list of service providers.
+func HandleListServiceProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListServiceProvidersOutput)
+	})
+}
+
+// HandleServiceProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/service_providers/partner` on the test handler mux that
+// gets, creates and deletes the service provider.
+func HandleServiceProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers/partner", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ServiceProviderOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateServiceProviderRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ServiceProviderOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleFakeOIDCProvider creates HTTP handlers under `/idp` on the test
+// handler mux that act as an OpenID Connect identity provider. It issues
+// tokens to the "keystone" client, with the password grant for user "jdoe"
+// and with the client credentials grant.
+func HandleFakeOIDCProvider(t *testing.T) {
+	th.Mux.HandleFunc("/idp/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"issuer": "%sidp", "token_endpoint": "%sidp/token"}`, th.Endpoint(), th.Endpoint())
+	})
+
+	th.Mux.HandleFunc("/idp/token", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/x-www-form-urlencoded")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "keystone" || clientSecret != "s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "invalid_client"}`)
+			return
+		}
+
+		r.ParseForm()
+		th.CheckEquals(t, "openid", r.Form.Get("scope"))
+		switch r.Form.Get("grant_type") {
+		case "password":
+			if r.Form.Get("username") != "jdoe" || r.Form.Get("password") != "hunter2" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "invalid_grant"}`)
+				return
+			}
+		case "client_credentials":
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "unsupported_grant_type"}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"access_token": "oidc-access-token",
+			"id_token": "oidc-id-token",
+			"token_type": "Bearer",
+			"expires_in": 300
+		}`)
+	})
+}
+
+// HandleFederatedAuthSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid/auth` on the test
+// handler mux that issues an unscoped token for the given bearer token.
+func HandleFederatedAuthSuccessfully(t *testing.T, bearer string) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+		th.TestHeader(t, r, "Authorization", "Bearer "+bearer)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "unscoped-federated-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, FederatedTokenOutput)
+	})
+}
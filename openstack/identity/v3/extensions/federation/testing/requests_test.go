@@ -0,0 +1,406 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListIdentityProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListIdentityProvidersSuccessfully(t)
+
+	count := 0
+	err := federation.ListIdentityProviders(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := federation.ExtractIdentityProviders(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []federation.IdentityProvider{ExpectedIdentityProvider}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListIdentityProvidersOpts(t *testing.T) {
+	enabled := false
+	opts := federation.ListIdentityProvidersOpts{
+		ID:      "corp",
+		Enabled: &enabled,
+	}
+
+	query, err := opts.ToIdentityProviderListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?enabled=false&id=corp", query)
+}
+
+func TestGetIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	actual, err := federation.GetIdentityProvider(client.ServiceClient(), "corp").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedIdentityProvider, *actual)
+}
+
+func TestCreateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	enabled := true
+	ttl := 60
+	opts := federation.CreateIdentityProviderOpts{
+		Description:      "Corporate OpenID Connect provider",
+		DomainID:         "ad8bc5",
+		Enabled:          &enabled,
+		RemoteIDs:        []string{"https://idp.example.com"},
+		AuthorizationTTL: &ttl,
+	}
+
+	actual, err := federation.CreateIdentityProvider(client.ServiceClient(), "corp", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedIdentityProvider, *actual)
+}
+
+func TestUpdateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	enabled := false
+	opts := federation.UpdateIdentityProviderOpts{
+		Enabled: &enabled,
+	}
+
+	actual, err := federation.UpdateIdentityProvider(client.ServiceClient(), "corp", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, actual.Enabled)
+}
+
+func TestDeleteIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	err := federation.DeleteIdentityProvider(client.ServiceClient(), "corp").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListProtocols(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProtocolsSuccessfully(t)
+
+	allPages, err := federation.ListProtocols(client.ServiceClient(), "corp").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := federation.ExtractProtocols(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.Protocol{ExpectedProtocol}, actual)
+}
+
+func TestProtocolCRUD(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtocolSuccessfully(t)
+
+	opts := federation.ProtocolOpts{
+		MappingID:         "corp-mapping",
+		RemoteIDAttribute: "HTTP_OIDC_ISS",
+	}
+
+	actual, err := federation.CreateProtocol(client.ServiceClient(), "corp", "openid", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProtocol, *actual)
+
+	actual, err = federation.GetProtocol(client.ServiceClient(), "corp", "openid").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProtocol, *actual)
+
+	actual, err = federation.UpdateProtocol(client.ServiceClient(), "corp", "openid", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProtocol, *actual)
+
+	err = federation.DeleteProtocol(client.ServiceClient(), "corp", "openid").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListMappings(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListMappingsSuccessfully(t)
+
+	allPages, err := federation.ListMappings(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := federation.ExtractMappings(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.Mapping{ExpectedMapping}, actual)
+}
+
+func TestMappingCRUD(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingSuccessfully(t)
+
+	opts := federation.MappingOpts{
+		Rules: ExpectedMappingRules,
+	}
+
+	actual, err := federation.CreateMapping(client.ServiceClient(), "corp-mapping", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedMapping, *actual)
+
+	actual, err = federation.GetMapping(client.ServiceClient(), "corp-mapping").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedMapping, *actual)
+
+	actual, err = federation.UpdateMapping(client.ServiceClient(), "corp-mapping", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedMapping, *actual)
+
+	err = federation.DeleteMapping(client.ServiceClient(), "corp-mapping").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListServiceProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListServiceProvidersSuccessfully(t)
+
+	allPages, err := federation.ListServiceProviders(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := federation.ExtractServiceProviders(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.ServiceProvider{ExpectedServiceProvider}, actual)
+}
+
+func TestServiceProviderCRUD(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	enabled := true
+	opts := federation.CreateServiceProviderOpts{
+		AuthURL:     ExpectedServiceProvider.AuthURL,
+		SPURL:       ExpectedServiceProvider.SPURL,
+		Description: "Partner cloud",
+		Enabled:     &enabled,
+	}
+
+	actual, err := federation.CreateServiceProvider(client.ServiceClient(), "partner", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServiceProvider, *actual)
+
+	actual, err = federation.GetServiceProvider(client.ServiceClient(), "partner").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServiceProvider, *actual)
+
+	err = federation.DeleteServiceProvider(client.ServiceClient(), "partner").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateServiceProviderRequiresURLs(t *testing.T) {
+	_, err := federation.CreateServiceProviderOpts{
+		AuthURL: ExpectedServiceProvider.AuthURL,
+	}.ToServiceProviderCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for a service provider without an SP URL")
+	}
+}
+
+func TestOIDCTokenRequest(t *testing.T) {
+	opts := federation.OIDCAuthOptions{
+		ClientID: "keystone",
+		Username: "jdoe",
+		Password: "hunter2",
+		Scope:    []string{"openid", "profile"},
+	}
+
+	form, err := opts.ToOIDCTokenRequest()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "client_id=keystone&grant_type=password&password=hunter2&scope=openid+profile&username=jdoe", form.Encode())
+
+	opts = federation.OIDCAuthOptions{
+		ClientID:     "keystone",
+		ClientSecret: "s3cr3t",
+	}
+
+	form, err = opts.ToOIDCTokenRequest()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "grant_type=client_credentials&scope=openid", form.Encode())
+}
+
+func TestOIDCTokenRequestErrors(t *testing.T) {
+	_, err := federation.OIDCAuthOptions{}.ToOIDCTokenRequest()
+	th.CheckDeepEquals(t, gophercloud.ErrMissingInput{Argument: "ClientID"}, err)
+
+	_, err = federation.OIDCAuthOptions{ClientID: "keystone", Username: "jdoe"}.ToOIDCTokenRequest()
+	th.CheckDeepEquals(t, gophercloud.ErrMissingInput{Argument: "Password"}, err)
+}
+
+func TestGetOIDCTokenWithDiscovery(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFakeOIDCProvider(t)
+
+	opts := federation.OIDCAuthOptions{
+		DiscoveryEndpoint: th.Endpoint() + "idp/.well-known/openid-configuration",
+		ClientID:          "keystone",
+		ClientSecret:      "s3cr3t",
+	}
+
+	actual, err := federation.GetOIDCToken(client.ServiceClient().ProviderClient, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, federation.OIDCToken{
+		AccessToken: "oidc-access-token",
+		IDToken:     "oidc-id-token",
+		TokenType:   "Bearer",
+		ExpiresIn:   300,
+	}, *actual)
+}
+
+func TestGetOIDCTokenErrors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFakeOIDCProvider(t)
+	th.Mux.HandleFunc("/no-token-endpoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"issuer": "https://idp.example.com"}`)
+	})
+
+	provider := client.ServiceClient().ProviderClient
+
+	_, err := federation.GetOIDCToken(provider, federation.OIDCAuthOptions{
+		ClientID: "keystone",
+	}).Extract()
+	th.CheckDeepEquals(t, gophercloud.ErrMissingInput{Argument: "TokenEndpoint/DiscoveryEndpoint"}, err)
+
+	_, err = federation.GetOIDCToken(provider, federation.OIDCAuthOptions{
+		DiscoveryEndpoint: th.Endpoint() + "no-token-endpoint",
+		ClientID:          "keystone",
+	}).Extract()
+	if _, ok := err.(federation.ErrOIDCTokenEndpointNotFound); !ok {
+		t.Fatalf("expected ErrOIDCTokenEndpointNotFound, got %#v", err)
+	}
+
+	_, err = federation.GetOIDCToken(provider, federation.OIDCAuthOptions{
+		TokenEndpoint: th.Endpoint() + "idp/token",
+		ClientID:      "keystone",
+		ClientSecret:  "wrong",
+	}).Extract()
+	if err, ok := err.(gophercloud.ErrUnexpectedResponseCode); !ok || err.Actual != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 from the identity provider, got %#v", err)
+	}
+}
+
+func TestAuthenticateOIDCAndRescope(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFakeOIDCProvider(t)
+	HandleFederatedAuthSuccessfully(t, "oidc-access-token")
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["token"],
+						"token": {
+							"id": "unscoped-federated-token"
+						}
+					},
+					"scope": {
+						"project": {
+							"id": "263fd9"
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "scoped-federated-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"token": {"expires_at": "2026-10-19T11:04:39.000000Z"}}`)
+	})
+
+	opts := federation.OIDCAuthOptions{
+		IdentityProviderID: "corp",
+		ProtocolID:         "openid",
+		TokenEndpoint:      th.Endpoint() + "idp/token",
+		ClientID:           "keystone",
+		ClientSecret:       "s3cr3t",
+		Username:           "jdoe",
+		Password:           "hunter2",
+	}
+
+	unscoped, err := federation.AuthenticateOIDC(client.ServiceClient(), opts).ExtractToken()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, tokens.Token{
+		ID:        "unscoped-federated-token",
+		ExpiresAt: time.Date(2026, 10, 19, 11, 4, 39, 0, time.UTC),
+	}, *unscoped)
+
+	scoped, err := tokens.Create(client.ServiceClient(), &tokens.AuthOptions{TokenID: unscoped.ID}, &tokens.Scope{ProjectID: "263fd9"}).ExtractToken()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "scoped-federated-token", scoped.ID)
+}
+
+func TestAuthenticateOIDCWithIDToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFakeOIDCProvider(t)
+	HandleFederatedAuthSuccessfully(t, "oidc-id-token")
+
+	opts := federation.OIDCAuthOptions{
+		IdentityProviderID: "corp",
+		ProtocolID:         "openid",
+		DiscoveryEndpoint:  th.Endpoint() + "idp/.well-known/openid-configuration",
+		ClientID:           "keystone",
+		ClientSecret:       "s3cr3t",
+		TokenType:          federation.OIDCIDToken,
+	}
+
+	actual, err := federation.AuthenticateOIDC(client.ServiceClient(), opts).ExtractToken()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "unscoped-federated-token", actual.ID)
+}
+
+func TestAuthenticateOIDCErrors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFakeOIDCProvider(t)
+
+	opts := federation.OIDCAuthOptions{
+		ProtocolID:    "openid",
+		TokenEndpoint: th.Endpoint() + "idp/token",
+		ClientID:      "keystone",
+		ClientSecret:  "s3cr3t",
+	}
+
+	_, err := federation.AuthenticateOIDC(client.ServiceClient(), opts).ExtractToken()
+	th.CheckDeepEquals(t, gophercloud.ErrMissingInput{Argument: "IdentityProviderID"}, err)
+
+	opts.IdentityProviderID = "corp"
+	opts.TokenType = "refresh_token"
+	_, err = federation.AuthenticateOIDC(client.ServiceClient(), opts).ExtractToken()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %#v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package federation
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	ExtPath              = "OS-FEDERATION"
+	IdentityProviderPath = "identity_providers"
+	ProtocolPath         = "protocols"
+	MappingPath          = "mappings"
+	ServiceProviderPath  = "service_providers"
+	AuthPath             = "auth"
+)
+
+func identityProvidersURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, IdentityProviderPath)
+}
+
+func identityProviderURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(ExtPath, IdentityProviderPath, idpID)
+}
+
+func protocolsURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(ExtPath, IdentityProviderPath, idpID, ProtocolPath)
+}
+
+func protocolURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL(ExtPath, IdentityProviderPath, idpID, ProtocolPath, protocolID)
+}
+
+func federatedAuthURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL(ExtPath, IdentityProviderPath, idpID, ProtocolPath, protocolID, AuthPath)
+}
+
+func mappingsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, MappingPath)
+}
+
+func mappingURL(c *gophercloud.ServiceClient, mappingID string) string {
+	return c.ServiceURL(ExtPath, MappingPath, mappingID)
+}
+
+func serviceProvidersURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, ServiceProviderPath)
+}
+
+func serviceProviderURL(c *gophercloud.ServiceClient, spID string) string {
+	return c.ServiceURL(ExtPath, ServiceProviderPath, spID)
+}
@@ -0,0 +1,109 @@
+/*
+Package oauth1 enables management of OpenStack OAuth1 consumers and
+delegated access tokens (OS-OAUTH1), and authentication with them.
+
+A consumer obtains a request token for a project, which a user authorizes
+by delegating some of their roles on the project to the consumer. The
+consumer then exchanges the request token for an access token, which it
+authenticates with. The requests of the consumer are signed with OAuth 1.0a
+HMAC-SHA1, see Sign.
+
+Example to Create a Consumer
+
+	createOpts := oauth1.CreateConsumerOpts{
+		Description: "My consumer",
+	}
+
+	consumer, err := oauth1.CreateConsumer(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The consumer secret is only returned at creation.
+	fmt.Println(consumer.Secret)
+
+Example to Request a Token
+
+	requestTokenOpts := oauth1.RequestTokenOpts{
+		ConsumerKey:        consumer.ID,
+		ConsumerSecret:     consumer.Secret,
+		RequestedProjectID: "b9fca3",
+	}
+
+	requestToken, err := oauth1.RequestToken(identityClient, requestTokenOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authorize a Request Token
+
+	authorizeOpts := oauth1.AuthorizeTokenOpts{
+		Roles: []oauth1.Role{
+			{Name: "member"},
+		},
+	}
+
+	authorizedToken, err := oauth1.AuthorizeToken(identityClient, requestToken.ID, authorizeOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Access Token
+
+	accessTokenOpts := oauth1.CreateAccessTokenOpts{
+		ConsumerKey:        consumer.ID,
+		ConsumerSecret:     consumer.Secret,
+		RequestToken:       requestToken.ID,
+		RequestTokenSecret: requestToken.Secret,
+		Verifier:           authorizedToken.Verifier,
+	}
+
+	accessToken, err := oauth1.CreateAccessToken(identityClient, accessTokenOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate with an Access Token
+
+	authOptions := oauth1.AuthOptions{
+		ConsumerKey:       consumer.ID,
+		ConsumerSecret:    consumer.Secret,
+		AccessToken:       accessToken.ID,
+		AccessTokenSecret: accessToken.Secret,
+		AllowReauth:       true,
+	}
+
+	provider, err := openstack.NewClient("https://keystone.example.com:5000/v3")
+	if err != nil {
+		panic(err)
+	}
+
+	err = openstack.AuthenticateV3OAuth1(provider, authOptions, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Access Tokens of a User
+
+	allPages, err := oauth1.ListAccessTokens(identityClient, "ce9e07").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAccessTokens, err := oauth1.ExtractAccessTokens(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, accessToken := range allAccessTokens {
+		fmt.Printf("%+v\n", accessToken)
+	}
+
+Example to Revoke an Access Token
+
+	err := oauth1.RevokeAccessToken(identityClient, "ce9e07", "6be26a").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package oauth1
@@ -0,0 +1,583 @@
+package oauth1
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// HMACSHA1 is the OAuth signature method the Identity service accepts.
+const HMACSHA1 = "HMAC-SHA1"
+
+// oobCallback is the callback of request tokens: the Identity service does
+// not redirect users, the verifier is returned by AuthorizeToken instead.
+const oobCallback = "oob"
+
+// ListConsumers enumerates the consumers.
+func ListConsumers(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, consumersURL(client), func(r pagination.PageResult) pagination.Page {
+		return ConsumerPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetConsumer retrieves a consumer, given its ID.
+func GetConsumer(client *gophercloud.ServiceClient, consumerID string) (r ConsumerResult) {
+	_, r.Err = client.Get(consumerURL(client, consumerID), &r.Body, nil)
+	return
+}
+
+// CreateConsumerOptsBuilder allows extensions to add additional parameters to
+// the CreateConsumer request.
+type CreateConsumerOptsBuilder interface {
+	ToOAuth1CreateConsumerMap() (map[string]interface{}, error)
+}
+
+// CreateConsumerOpts specifies the attributes of a new consumer.
+type CreateConsumerOpts struct {
+	// Description is the description of the consumer.
+	Description string `json:"description,omitempty"`
+}
+
+// ToOAuth1CreateConsumerMap formats a CreateConsumerOpts into a create
+// request.
+func (opts CreateConsumerOpts) ToOAuth1CreateConsumerMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "consumer")
+}
+
+// CreateConsumer registers a consumer. The secret of the consumer is only
+// returned by this request.
+func CreateConsumer(client *gophercloud.ServiceClient, opts CreateConsumerOptsBuilder) (r ConsumerResult) {
+	b, err := opts.ToOAuth1CreateConsumerMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(consumersURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateConsumerOptsBuilder allows extensions to add additional parameters to
+// the UpdateConsumer request.
+type UpdateConsumerOptsBuilder interface {
+	ToOAuth1UpdateConsumerMap() (map[string]interface{}, error)
+}
+
+// UpdateConsumerOpts specifies the attributes of a consumer to modify.
+type UpdateConsumerOpts struct {
+	// Description is the new description of the consumer.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToOAuth1UpdateConsumerMap formats an UpdateConsumerOpts into an update
+// request.
+func (opts UpdateConsumerOpts) ToOAuth1UpdateConsumerMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "consumer")
+}
+
+// UpdateConsumer modifies the attributes of a consumer.
+func UpdateConsumer(client *gophercloud.ServiceClient, consumerID string, opts UpdateConsumerOptsBuilder) (r ConsumerResult) {
+	b, err := opts.ToOAuth1UpdateConsumerMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(consumerURL(client, consumerID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteConsumer deletes a consumer, along with its request and access
+// tokens.
+func DeleteConsumer(client *gophercloud.ServiceClient, consumerID string) (r DeleteResult) {
+	_, r.Err = client.Delete(consumerURL(client, consumerID), nil)
+	return
+}
+
+// SignOpts are the OAuth 1.0a parameters a request is signed with.
+type SignOpts struct {
+	// ConsumerKey and ConsumerSecret are the ID and the secret of the
+	// consumer.
+	ConsumerKey    string
+	ConsumerSecret string
+
+	// Token and TokenSecret are the ID and the secret of the request or
+	// access token, if any.
+	Token       string
+	TokenSecret string
+
+	// Callback is the oauth_callback parameter of a request token request.
+	Callback string
+
+	// Verifier is the oauth_verifier parameter of an access token request.
+	Verifier string
+
+	// Timestamp is the time at which the request is signed. It defaults to
+	// the current time.
+	Timestamp time.Time
+
+	// Nonce is a random string unique to the request. A new one is generated
+	// if it is empty.
+	Nonce string
+}
+
+// Sign signs a request to method and rawURL with OAuth 1.0a (RFC 5849)
+// HMAC-SHA1, and returns the value of its Authorization header. The query
+// parameters of rawURL are signed along with the OAuth parameters; the body
+// of the request is not signed.
+func Sign(method, rawURL string, opts SignOpts) (string, error) {
+	if opts.ConsumerKey == "" {
+		return "", gophercloud.ErrMissingInput{Argument: "ConsumerKey"}
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	timestamp := opts.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	nonce := opts.Nonce
+	if nonce == "" {
+		b := make([]byte, 12)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		nonce = hex.EncodeToString(b)
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     opts.ConsumerKey,
+		"oauth_nonce":            nonce,
+		"oauth_signature_method": HMACSHA1,
+		"oauth_timestamp":        strconv.FormatInt(timestamp.Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if opts.Token != "" {
+		params["oauth_token"] = opts.Token
+	}
+	if opts.Callback != "" {
+		params["oauth_callback"] = opts.Callback
+	}
+	if opts.Verifier != "" {
+		params["oauth_verifier"] = opts.Verifier
+	}
+
+	key := percentEncode(opts.ConsumerSecret) + "&" + percentEncode(opts.TokenSecret)
+	h := hmac.New(sha1.New, []byte(key))
+	h.Write([]byte(signatureBaseString(method, u, params)))
+	params["oauth_signature"] = base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fields := make([]string, len(names))
+	for i, name := range names {
+		fields[i] = fmt.Sprintf(`%s="%s"`, name, percentEncode(params[name]))
+	}
+
+	return "OAuth " + strings.Join(fields, ", "), nil
+}
+
+// signatureBaseString returns the signature base string of a request, as
+// defined by section 3.4.1 of RFC 5849.
+func signatureBaseString(method string, u *url.URL, oauthParams map[string]string) string {
+	type param struct {
+		name, value string
+	}
+
+	var params []param
+	for name, values := range u.Query() {
+		for _, value := range values {
+			params = append(params, param{percentEncode(name), percentEncode(value)})
+		}
+	}
+	for name, value := range oauthParams {
+		params = append(params, param{percentEncode(name), percentEncode(value)})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].name != params[j].name {
+			return params[i].name < params[j].name
+		}
+		return params[i].value < params[j].value
+	})
+	pairs := make([]string, len(params))
+	for i, p := range params {
+		pairs[i] = p.name + "=" + p.value
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := u.Port(); port != "" && !(scheme == "http" && port == "80") && !(scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	return strings.Join([]string{
+		strings.ToUpper(method),
+		percentEncode(scheme + "://" + host + path),
+		percentEncode(strings.Join(pairs, "&")),
+	}, "&")
+}
+
+// percentEncode encodes s as required by section 3.6 of RFC 5849: every byte
+// but the unreserved characters of RFC 3986 is escaped.
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// RequestTokenOptsBuilder allows extensions to add additional parameters to
+// the RequestToken request.
+type RequestTokenOptsBuilder interface {
+	ToOAuth1RequestTokenHeaders(method, url string) (map[string]string, error)
+}
+
+// RequestTokenOpts specifies the consumer requesting a token and the project
+// the token is for.
+type RequestTokenOpts struct {
+	// ConsumerKey and ConsumerSecret are the ID and the secret of the
+	// consumer.
+	ConsumerKey    string
+	ConsumerSecret string
+
+	// RequestedProjectID is the ID of the project the consumer asks to be
+	// delegated roles on.
+	RequestedProjectID string
+
+	// Timestamp and Nonce override the OAuth parameters of the same name, to
+	// obtain reproducible requests.
+	Timestamp time.Time
+	Nonce     string
+}
+
+// ToOAuth1RequestTokenHeaders formats a RequestTokenOpts into the headers of
+// a signed request token request.
+func (opts RequestTokenOpts) ToOAuth1RequestTokenHeaders(method, url string) (map[string]string, error) {
+	if opts.ConsumerKey == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "ConsumerKey"}
+	}
+	if opts.RequestedProjectID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "RequestedProjectID"}
+	}
+
+	authorization, err := Sign(method, url, SignOpts{
+		ConsumerKey:    opts.ConsumerKey,
+		ConsumerSecret: opts.ConsumerSecret,
+		Callback:       oobCallback,
+		Timestamp:      opts.Timestamp,
+		Nonce:          opts.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		"Authorization":        authorization,
+		"Requested-Project-Id": opts.RequestedProjectID,
+	}, nil
+}
+
+// RequestToken obtains an unauthorized request token for a consumer. The
+// token must then be authorized by a user with AuthorizeToken, before the
+// consumer exchanges it for an access token with CreateAccessToken.
+func RequestToken(client *gophercloud.ServiceClient, opts RequestTokenOptsBuilder) (r TokenResult) {
+	url := requestTokenURL(client)
+	h, err := opts.ToOAuth1RequestTokenHeaders("POST", url)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	r = createToken(client, url, h)
+	return
+}
+
+// AuthorizeTokenOptsBuilder allows extensions to add additional parameters to
+// the AuthorizeToken request.
+type AuthorizeTokenOptsBuilder interface {
+	ToOAuth1AuthorizeTokenMap() (map[string]interface{}, error)
+}
+
+// Role identifies a role, by ID or by name.
+type Role struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// AuthorizeTokenOpts specifies the roles delegated to the consumer.
+type AuthorizeTokenOpts struct {
+	// Roles lists the roles of the user on the requested project that are
+	// delegated to the consumer.
+	Roles []Role `json:"roles" required:"true"`
+}
+
+// ToOAuth1AuthorizeTokenMap formats an AuthorizeTokenOpts into an authorize
+// request.
+func (opts AuthorizeTokenOpts) ToOAuth1AuthorizeTokenMap() (map[string]interface{}, error) {
+	for _, role := range opts.Roles {
+		if role.ID == "" && role.Name == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "Roles.ID/Roles.Name"}
+		}
+	}
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// AuthorizeToken authorizes a request token on behalf of the authenticated
+// user, delegating roles of the user on the requested project to the
+// consumer. The verifier returned must be passed on to the consumer.
+func AuthorizeToken(client *gophercloud.ServiceClient, requestTokenID string, opts AuthorizeTokenOptsBuilder) (r AuthorizeTokenResult) {
+	b, err := opts.ToOAuth1AuthorizeTokenMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(authorizeTokenURL(client, requestTokenID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// CreateAccessTokenOptsBuilder allows extensions to add additional parameters
+// to the CreateAccessToken request.
+type CreateAccessTokenOptsBuilder interface {
+	ToOAuth1CreateAccessTokenHeaders(method, url string) (map[string]string, error)
+}
+
+// CreateAccessTokenOpts specifies the authorized request token to exchange
+// for an access token.
+type CreateAccessTokenOpts struct {
+	// ConsumerKey and ConsumerSecret are the ID and the secret of the
+	// consumer.
+	ConsumerKey    string
+	ConsumerSecret string
+
+	// RequestToken and RequestTokenSecret are the ID and the secret of the
+	// request token.
+	RequestToken       string
+	RequestTokenSecret string
+
+	// Verifier is the verifier returned when the request token was
+	// authorized.
+	Verifier string
+
+	// Timestamp and Nonce override the OAuth parameters of the same name, to
+	// obtain reproducible requests.
+	Timestamp time.Time
+	Nonce     string
+}
+
+// ToOAuth1CreateAccessTokenHeaders formats a CreateAccessTokenOpts into the
+// headers of a signed access token request.
+func (opts CreateAccessTokenOpts) ToOAuth1CreateAccessTokenHeaders(method, url string) (map[string]string, error) {
+	if opts.ConsumerKey == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "ConsumerKey"}
+	}
+	if opts.RequestToken == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "RequestToken"}
+	}
+	if opts.Verifier == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "Verifier"}
+	}
+
+	authorization, err := Sign(method, url, SignOpts{
+		ConsumerKey:    opts.ConsumerKey,
+		ConsumerSecret: opts.ConsumerSecret,
+		Token:          opts.RequestToken,
+		TokenSecret:    opts.RequestTokenSecret,
+		Verifier:       opts.Verifier,
+		Timestamp:      opts.Timestamp,
+		Nonce:          opts.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"Authorization": authorization}, nil
+}
+
+// CreateAccessToken exchanges an authorized request token for an access
+// token, which the consumer can then authenticate with.
+func CreateAccessToken(client *gophercloud.ServiceClient, opts CreateAccessTokenOptsBuilder) (r TokenResult) {
+	url := createAccessTokenURL(client)
+	h, err := opts.ToOAuth1CreateAccessTokenHeaders("POST", url)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	r = createToken(client, url, h)
+	return
+}
+
+// createToken issues a signed request token or access token request. The
+// consumer is not authenticated with a token, and the response is form
+// encoded rather than JSON.
+func createToken(client *gophercloud.ServiceClient, url string, h map[string]string) (r TokenResult) {
+	h["X-Auth-Token"] = ""
+	resp, err := client.Post(url, nil, nil, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{201},
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+	defer resp.Body.Close()
+
+	r.Header = resp.Header
+	r.Body, r.Err = ioutil.ReadAll(resp.Body)
+	return
+}
+
+// ListAccessTokens enumerates the access tokens a user authorized.
+func ListAccessTokens(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	return pagination.NewPager(client, accessTokensURL(client, userID), func(r pagination.PageResult) pagination.Page {
+		return AccessTokenPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetAccessToken retrieves an access token a user authorized.
+func GetAccessToken(client *gophercloud.ServiceClient, userID, accessTokenID string) (r AccessTokenResult) {
+	_, r.Err = client.Get(accessTokenURL(client, userID, accessTokenID), &r.Body, nil)
+	return
+}
+
+// RevokeAccessToken revokes an access token a user authorized, along with
+// the tokens obtained with it.
+func RevokeAccessToken(client *gophercloud.ServiceClient, userID, accessTokenID string) (r DeleteResult) {
+	_, r.Err = client.Delete(accessTokenURL(client, userID, accessTokenID), nil)
+	return
+}
+
+// ListAccessTokenRoles enumerates the roles delegated by an access token.
+func ListAccessTokenRoles(client *gophercloud.ServiceClient, userID, accessTokenID string) pagination.Pager {
+	return pagination.NewPager(client, accessTokenRolesURL(client, userID, accessTokenID), func(r pagination.PageResult) pagination.Page {
+		return AccessTokenRolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetAccessTokenRole retrieves a role delegated by an access token.
+func GetAccessTokenRole(client *gophercloud.ServiceClient, userID, accessTokenID, roleID string) (r AccessTokenRoleResult) {
+	_, r.Err = client.Get(accessTokenRoleURL(client, userID, accessTokenID, roleID), &r.Body, nil)
+	return
+}
+
+// AuthOptionsBuilder allows extensions to add additional parameters to the
+// Create request.
+type AuthOptionsBuilder interface {
+	ToOAuth1AuthHeaders(method, url string) (map[string]string, error)
+}
+
+// AuthOptions describes a consumer authenticating with an access token. The
+// token obtained is scoped to the project of the access token and carries
+// the roles the access token delegates.
+type AuthOptions struct {
+	// ConsumerKey and ConsumerSecret are the ID and the secret of the
+	// consumer.
+	ConsumerKey    string
+	ConsumerSecret string
+
+	// AccessToken and AccessTokenSecret are the ID and the secret of the
+	// access token.
+	AccessToken       string
+	AccessTokenSecret string
+
+	// Timestamp and Nonce override the OAuth parameters of the same name, to
+	// obtain reproducible requests.
+	Timestamp time.Time
+	Nonce     string
+
+	// AllowReauth allows openstack.AuthenticateV3OAuth1 to authenticate again
+	// with the same options when the token expires.
+	AllowReauth bool
+}
+
+// ToOAuth1AuthHeaders formats an AuthOptions into the headers of a signed
+// authentication request.
+func (opts AuthOptions) ToOAuth1AuthHeaders(method, url string) (map[string]string, error) {
+	if opts.ConsumerKey == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "ConsumerKey"}
+	}
+	if opts.AccessToken == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "AccessToken"}
+	}
+
+	authorization, err := Sign(method, url, SignOpts{
+		ConsumerKey:    opts.ConsumerKey,
+		ConsumerSecret: opts.ConsumerSecret,
+		Token:          opts.AccessToken,
+		TokenSecret:    opts.AccessTokenSecret,
+		Timestamp:      opts.Timestamp,
+		Nonce:          opts.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"Authorization": authorization}, nil
+}
+
+// Create authenticates with the oauth1 method, and returns a token scoped to
+// the project of the access token. The result is interpreted like the result
+// of tokens.Create.
+func Create(client *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r tokens.CreateResult) {
+	url := authURL(client)
+	h, err := opts.ToOAuth1AuthHeaders("POST", url)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	h["X-Auth-Token"] = ""
+
+	b := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"oauth1"},
+				"oauth1":  map[string]interface{}{},
+			},
+		},
+	}
+	resp, err := client.Post(url, b, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{201},
+	})
+	r.Err = err
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	return
+}
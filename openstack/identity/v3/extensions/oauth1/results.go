@@ -0,0 +1,290 @@
+package oauth1
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Consumer is a third-party application that users may delegate roles to.
+type Consumer struct {
+	// ID is the ID of the consumer, used as the OAuth consumer key.
+	ID string `json:"id"`
+
+	// Secret is the secret of the consumer. It is only returned when the
+	// consumer is created.
+	Secret string `json:"secret"`
+
+	// Description is the description of the consumer.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the consumer.
+	Links map[string]interface{} `json:"links"`
+}
+
+// ConsumerResult is the response from a GetConsumer, CreateConsumer or
+// UpdateConsumer operation. Call its Extract method to interpret it as a
+// Consumer.
+type ConsumerResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ConsumerResult as a Consumer.
+func (r ConsumerResult) Extract() (*Consumer, error) {
+	var s struct {
+		Consumer *Consumer `json:"consumer"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Consumer, err
+}
+
+// ConsumerPage is a single page of Consumer results.
+type ConsumerPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ConsumerPage contains any results.
+func (r ConsumerPage) IsEmpty() (bool, error) {
+	consumers, err := ExtractConsumers(r)
+	return len(consumers) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ConsumerPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractConsumers returns a slice of Consumers contained in a single page of
+// results.
+func ExtractConsumers(r pagination.Page) ([]Consumer, error) {
+	var s struct {
+		Consumers []Consumer `json:"consumers"`
+	}
+	err := (r.(ConsumerPage)).ExtractInto(&s)
+	return s.Consumers, err
+}
+
+// DeleteResult is the response from a DeleteConsumer or RevokeAccessToken
+// operation. Call its ExtractErr method to determine if the request succeeded
+// or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Token is a request token or an access token, along with its secret.
+type Token struct {
+	// ID is the ID of the token, used as the OAuth token.
+	ID string
+
+	// Secret is the secret of the token, used to sign the requests made
+	// with it.
+	Secret string
+
+	// ExpiresAt is the time at which the token expires. It is the zero time
+	// if the token does not expire.
+	ExpiresAt time.Time
+}
+
+// TokenResult is the response from a RequestToken or CreateAccessToken
+// operation. Call its Extract method to interpret it as a Token.
+type TokenResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a TokenResult as a Token. The response is form encoded.
+func (r TokenResult) Extract() (*Token, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	b, _ := r.Body.([]byte)
+	values, err := url.ParseQuery(string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{
+		ID:     values.Get("oauth_token"),
+		Secret: values.Get("oauth_token_secret"),
+	}
+	if expiresAt := values.Get("oauth_expires_at"); expiresAt != "" {
+		token.ExpiresAt, err = time.Parse(gophercloud.RFC3339Milli, expiresAt)
+	}
+	return token, err
+}
+
+// AuthorizedToken is the outcome of the authorization of a request token.
+type AuthorizedToken struct {
+	// Verifier proves to the Identity service that the consumer was
+	// authorized by the user. It must be presented with the request token to
+	// obtain an access token.
+	Verifier string `json:"oauth_verifier"`
+}
+
+// AuthorizeTokenResult is the response from an AuthorizeToken operation.
+// Call its Extract method to interpret it as an AuthorizedToken.
+type AuthorizeTokenResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AuthorizeTokenResult as an AuthorizedToken.
+func (r AuthorizeTokenResult) Extract() (*AuthorizedToken, error) {
+	var s struct {
+		AuthorizedToken *AuthorizedToken `json:"token"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AuthorizedToken, err
+}
+
+// AccessToken is an access token a user authorized, as listed by the
+// Identity service. Its secret is never returned.
+type AccessToken struct {
+	// ID is the ID of the access token.
+	ID string `json:"id"`
+
+	// ConsumerID is the ID of the consumer the access token was issued to.
+	ConsumerID string `json:"consumer_id"`
+
+	// ProjectID is the ID of the project roles are delegated on.
+	ProjectID string `json:"project_id"`
+
+	// AuthorizingUserID is the ID of the user who delegated the roles.
+	AuthorizingUserID string `json:"authorizing_user_id"`
+
+	// ExpiresAt is the time at which the access token expires. It is the
+	// zero time if the access token does not expire.
+	ExpiresAt time.Time `json:"-"`
+
+	// Links contains referencing links to the access token.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (t *AccessToken) UnmarshalJSON(b []byte) error {
+	type tmp AccessToken
+	var s struct {
+		tmp
+		ExpiresAt string `json:"expires_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*t = AccessToken(s.tmp)
+
+	if s.ExpiresAt != "" {
+		t.ExpiresAt, err = time.Parse(gophercloud.RFC3339Milli, s.ExpiresAt)
+	}
+	return err
+}
+
+// AccessTokenResult is the response from a GetAccessToken operation. Call its
+// Extract method to interpret it as an AccessToken.
+type AccessTokenResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AccessTokenResult as an AccessToken.
+func (r AccessTokenResult) Extract() (*AccessToken, error) {
+	var s struct {
+		AccessToken *AccessToken `json:"access_token"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AccessToken, err
+}
+
+// AccessTokenPage is a single page of AccessToken results.
+type AccessTokenPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an AccessTokenPage contains any results.
+func (r AccessTokenPage) IsEmpty() (bool, error) {
+	accessTokens, err := ExtractAccessTokens(r)
+	return len(accessTokens) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r AccessTokenPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractAccessTokens returns a slice of AccessTokens contained in a single
+// page of results.
+func ExtractAccessTokens(r pagination.Page) ([]AccessToken, error) {
+	var s struct {
+		AccessTokens []AccessToken `json:"access_tokens"`
+	}
+	err := (r.(AccessTokenPage)).ExtractInto(&s)
+	return s.AccessTokens, err
+}
+
+// AccessTokenRole is a role delegated by an access token.
+type AccessTokenRole struct {
+	// ID is the ID of the role.
+	ID string `json:"id"`
+
+	// Name is the name of the role.
+	Name string `json:"name"`
+
+	// DomainID is the ID of the domain of a domain-specific role.
+	DomainID string `json:"domain_id"`
+
+	// Links contains referencing links to the role.
+	Links map[string]interface{} `json:"links"`
+}
+
+// AccessTokenRoleResult is the response from a GetAccessTokenRole operation.
+// Call its Extract method to interpret it as an AccessTokenRole.
+type AccessTokenRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AccessTokenRoleResult as an AccessTokenRole.
+func (r AccessTokenRoleResult) Extract() (*AccessTokenRole, error) {
+	var s struct {
+		Role *AccessTokenRole `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
+}
+
+// AccessTokenRolePage is a single page of AccessTokenRole results.
+type AccessTokenRolePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an AccessTokenRolePage contains any
+// results.
+func (r AccessTokenRolePage) IsEmpty() (bool, error) {
+	roles, err := ExtractAccessTokenRoles(r)
+	return len(roles) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r AccessTokenRolePage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractAccessTokenRoles returns a slice of AccessTokenRoles contained in a
+// single page of results.
+func ExtractAccessTokenRoles(r pagination.Page) ([]AccessTokenRole, error) {
+	var s struct {
+		Roles []AccessTokenRole `json:"roles"`
+	}
+	err := (r.(AccessTokenRolePage)).ExtractInto(&s)
+	return s.Roles, err
+}
+
+func nextPageURL(r pagination.LinkedPageBase) (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
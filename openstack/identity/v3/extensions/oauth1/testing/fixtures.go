@@ -0,0 +1,593 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/oauth1"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListConsumersOutput provides a single page of Consumer results.
+const ListConsumersOutput = `
+{
+    "consumers": [
+        {
+            "id": "7fea2d",
+            "description": "My consumer",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d"
+            }
+        },
+        {
+            "id": "0c2a74",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-OAUTH1/consumers/0c2a74"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-OAUTH1/consumers"
+    }
+}
+`
+
+// GetConsumerOutput provides a GetConsumer result.
+const GetConsumerOutput = `
+{
+    "consumer": {
+        "id": "7fea2d",
+        "description": "My consumer",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d"
+        }
+    }
+}
+`
+
+// CreateConsumerRequest provides the input to a CreateConsumer request.
+const CreateConsumerRequest = `
+{
+    "consumer": {
+        "description": "My consumer"
+    }
+}
+`
+
+// CreateConsumerOutput provides a CreateConsumer result.
+const CreateConsumerOutput = `
+{
+    "consumer": {
+        "id": "7fea2d",
+        "secret": "4d2d5e",
+        "description": "My consumer",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d"
+        }
+    }
+}
+`
+
+// UpdateConsumerRequest provides the input to an UpdateConsumer request.
+const UpdateConsumerRequest = `
+{
+    "consumer": {
+        "description": "My new consumer"
+    }
+}
+`
+
+// UpdateConsumerOutput provides an UpdateConsumer result.
+const UpdateConsumerOutput = `
+{
+    "consumer": {
+        "id": "7fea2d",
+        "description": "My new consumer",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d"
+        }
+    }
+}
+`
+
+// RequestTokenOutput provides a RequestToken result.
+const RequestTokenOutput = `oauth_token=29971f&oauth_token_secret=238eb8&oauth_expires_at=2013-09-11T06:07:51.501805Z`
+
+// AuthorizeTokenRequest provides the input to an AuthorizeToken request.
+const AuthorizeTokenRequest = `
+{
+    "roles": [
+        {
+            "id": "a3b29b"
+        },
+        {
+            "name": "member"
+        }
+    ]
+}
+`
+
+// AuthorizeTokenOutput provides an AuthorizeToken result.
+const AuthorizeTokenOutput = `
+{
+    "token": {
+        "oauth_verifier": "8171"
+    }
+}
+`
+
+// CreateAccessTokenOutput provides a CreateAccessToken result.
+const CreateAccessTokenOutput = `oauth_token=accd36&oauth_token_secret=aa47da&oauth_expires_at=2013-09-11T06:07:51.501805Z`
+
+// ListAccessTokensOutput provides a single page of AccessToken results.
+const ListAccessTokensOutput = `
+{
+    "access_tokens": [
+        {
+            "consumer_id": "7fea2d",
+            "id": "6be26a",
+            "expires_at": "2013-09-11T06:07:51.501805Z",
+            "links": {
+                "roles": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles",
+                "self": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a"
+            },
+            "project_id": "b9fca3",
+            "authorizing_user_id": "ce9e07"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens"
+    }
+}
+`
+
+// GetAccessTokenOutput provides a GetAccessToken result.
+const GetAccessTokenOutput = `
+{
+    "access_token": {
+        "consumer_id": "7fea2d",
+        "id": "6be26a",
+        "expires_at": "2013-09-11T06:07:51.501805Z",
+        "links": {
+            "roles": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles",
+            "self": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a"
+        },
+        "project_id": "b9fca3",
+        "authorizing_user_id": "ce9e07"
+    }
+}
+`
+
+// ListAccessTokenRolesOutput provides a single page of AccessTokenRole
+// results.
+const ListAccessTokenRolesOutput = `
+{
+    "roles": [
+        {
+            "id": "5ad150",
+            "domain_id": "7cf37b",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/5ad150"
+            },
+            "name": "admin"
+        },
+        {
+            "id": "a62eb6",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/a62eb6"
+            },
+            "name": "member"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles"
+    }
+}
+`
+
+// GetAccessTokenRoleOutput provides a GetAccessTokenRole result.
+const GetAccessTokenRoleOutput = `
+{
+    "role": {
+        "id": "5ad150",
+        "domain_id": "7cf37b",
+        "links": {
+            "self": "http://example.com/identity/v3/roles/5ad150"
+        },
+        "name": "admin"
+    }
+}
+`
+
+// AuthenticateRequest provides the input to a Create request.
+const AuthenticateRequest = `
+{
+    "auth": {
+        "identity": {
+            "methods": [
+                "oauth1"
+            ],
+            "oauth1": {}
+        }
+    }
+}
+`
+
+// AuthenticateOutput provides a Create result.
+const AuthenticateOutput = `
+{
+    "token": {
+        "methods": [
+            "oauth1"
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "ce9e07",
+            "name": "alice"
+        },
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "b9fca3",
+            "name": "portal"
+        },
+        "roles": [
+            {
+                "id": "a62eb6",
+                "name": "member"
+            }
+        ],
+        "OS-OAUTH1": {
+            "access_token_id": "accd36",
+            "consumer_id": "7fea2d"
+        },
+        "audit_ids": [
+            "yRt0UrxJSs6-WYJgwEMMmg"
+        ],
+        "expires_at": "2013-09-11T07:07:51.501805Z",
+        "issued_at": "2013-09-11T06:07:51.501805Z",
+        "catalog": []
+    }
+}
+`
+
+// FirstConsumer is the first Consumer in the List request.
+var FirstConsumer = oauth1.Consumer{
+	ID:          "7fea2d",
+	Description: "My consumer",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d",
+	},
+}
+
+// SecondConsumer is the second Consumer in the List request.
+var SecondConsumer = oauth1.Consumer{
+	ID: "0c2a74",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-OAUTH1/consumers/0c2a74",
+	},
+}
+
+// ExpectedConsumersSlice is the slice of consumers expected to be returned
+// from ListConsumersOutput.
+var ExpectedConsumersSlice = []oauth1.Consumer{FirstConsumer, SecondConsumer}
+
+// CreatedConsumer is the Consumer returned by CreateConsumer.
+var CreatedConsumer = oauth1.Consumer{
+	ID:          "7fea2d",
+	Secret:      "4d2d5e",
+	Description: "My consumer",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d",
+	},
+}
+
+// UpdatedConsumer is the Consumer returned by UpdateConsumer.
+var UpdatedConsumer = oauth1.Consumer{
+	ID:          "7fea2d",
+	Description: "My new consumer",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-OAUTH1/consumers/7fea2d",
+	},
+}
+
+// Timestamp is the OAuth timestamp of the signed requests.
+var Timestamp = time.Date(2013, 9, 11, 6, 0, 0, 0, time.UTC)
+
+// Nonce is the OAuth nonce of the signed requests.
+const Nonce = "f2a1c9e0b7d4"
+
+// ExpectedRequestToken is the Token returned by RequestToken.
+var ExpectedRequestToken = oauth1.Token{
+	ID:        "29971f",
+	Secret:    "238eb8",
+	ExpiresAt: time.Date(2013, 9, 11, 6, 7, 51, 501805000, time.UTC),
+}
+
+// ExpectedAccessToken is the Token returned by CreateAccessToken.
+var ExpectedAccessToken = oauth1.Token{
+	ID:        "accd36",
+	Secret:    "aa47da",
+	ExpiresAt: time.Date(2013, 9, 11, 6, 7, 51, 501805000, time.UTC),
+}
+
+// UserAccessToken is the AccessToken in the List and Get requests.
+var UserAccessToken = oauth1.AccessToken{
+	ID:                "6be26a",
+	ConsumerID:        "7fea2d",
+	ProjectID:         "b9fca3",
+	AuthorizingUserID: "ce9e07",
+	ExpiresAt:         time.Date(2013, 9, 11, 6, 7, 51, 501805000, time.UTC),
+	Links: map[string]interface{}{
+		"roles": "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles",
+		"self":  "http://example.com/identity/v3/users/ce9e07/OS-OAUTH1/access_tokens/6be26a",
+	},
+}
+
+// AccessTokenAdminRole is the first AccessTokenRole in the List request.
+var AccessTokenAdminRole = oauth1.AccessTokenRole{
+	ID:       "5ad150",
+	Name:     "admin",
+	DomainID: "7cf37b",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/5ad150",
+	},
+}
+
+// AccessTokenMemberRole is the second AccessTokenRole in the List request.
+var AccessTokenMemberRole = oauth1.AccessTokenRole{
+	ID:   "a62eb6",
+	Name: "member",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/a62eb6",
+	},
+}
+
+// ExpectedAccessTokenRolesSlice is the slice of roles expected to be returned
+// from ListAccessTokenRolesOutput.
+var ExpectedAccessTokenRolesSlice = []oauth1.AccessTokenRole{AccessTokenAdminRole, AccessTokenMemberRole}
+
+// testAuthorization checks that a request is signed with opts, for the URL
+// it was sent to.
+func testAuthorization(t *testing.T, r *http.Request, opts oauth1.SignOpts) {
+	expected, err := oauth1.Sign(r.Method, "http://"+r.Host+r.URL.String(), opts)
+	th.AssertNoErr(t, err)
+	th.TestHeader(t, r, "Authorization", expected)
+	if _, ok := r.Header["X-Auth-Token"]; ok {
+		t.Errorf("Signed request sent a token")
+	}
+}
+
+// HandleListConsumersSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/consumers` on the test handler mux that responds with a list of
+// two consumers.
+func HandleListConsumersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/consumers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListConsumersOutput)
+	})
+}
+
+// HandleGetConsumerSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/consumers` on the test handler mux that responds with a single
+// consumer.
+func HandleGetConsumerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/consumers/7fea2d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetConsumerOutput)
+	})
+}
+
+// HandleCreateConsumerSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/consumers` on the test handler mux that tests consumer
+// creation.
+func HandleCreateConsumerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/consumers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateConsumerRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateConsumerOutput)
+	})
+}
+
+// HandleUpdateConsumerSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/consumers` on the test handler mux that tests consumer update.
+func HandleUpdateConsumerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/consumers/7fea2d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateConsumerRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateConsumerOutput)
+	})
+}
+
+// HandleDeleteConsumerSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/consumers` on the test handler mux that tests consumer
+// deletion.
+func HandleDeleteConsumerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/consumers/7fea2d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleRequestTokenSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/request_token` on the test handler mux that tests the signed
+// request of a request token.
+func HandleRequestTokenSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/request_token", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Requested-Project-Id", "b9fca3")
+		testAuthorization(t, r, oauth1.SignOpts{
+			ConsumerKey:    "7fea2d",
+			ConsumerSecret: "4d2d5e",
+			Callback:       "oob",
+			Timestamp:      Timestamp,
+			Nonce:          Nonce,
+		})
+
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, RequestTokenOutput)
+	})
+}
+
+// HandleAuthorizeTokenSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/authorize` on the test handler mux that tests the
+// authorization of a request token.
+func HandleAuthorizeTokenSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/authorize/29971f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, AuthorizeTokenRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, AuthorizeTokenOutput)
+	})
+}
+
+// HandleCreateAccessTokenSuccessfully creates an HTTP handler at
+// `/OS-OAUTH1/access_token` on the test handler mux that tests the signed
+// exchange of a request token for an access token.
+func HandleCreateAccessTokenSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-OAUTH1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		testAuthorization(t, r, oauth1.SignOpts{
+			ConsumerKey:    "7fea2d",
+			ConsumerSecret: "4d2d5e",
+			Token:          "29971f",
+			TokenSecret:    "238eb8",
+			Verifier:       "8171",
+			Timestamp:      Timestamp,
+			Nonce:          Nonce,
+		})
+
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateAccessTokenOutput)
+	})
+}
+
+// HandleListAccessTokensSuccessfully creates an HTTP handler at
+// `/users/ce9e07/OS-OAUTH1/access_tokens` on the test handler mux that
+// responds with a list of access tokens.
+func HandleListAccessTokensSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/ce9e07/OS-OAUTH1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListAccessTokensOutput)
+	})
+}
+
+// HandleGetAccessTokenSuccessfully creates an HTTP handler at
+// `/users/ce9e07/OS-OAUTH1/access_tokens` on the test handler mux that
+// responds with a single access token.
+func HandleGetAccessTokenSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/ce9e07/OS-OAUTH1/access_tokens/6be26a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetAccessTokenOutput)
+	})
+}
+
+// HandleRevokeAccessTokenSuccessfully creates an HTTP handler at
+// `/users/ce9e07/OS-OAUTH1/access_tokens` on the test handler mux that tests
+// access token revocation.
+func HandleRevokeAccessTokenSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/ce9e07/OS-OAUTH1/access_tokens/6be26a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListAccessTokenRolesSuccessfully creates an HTTP handler at
+// `/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles` on the test handler
+// mux that responds with a list of roles.
+func HandleListAccessTokenRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListAccessTokenRolesOutput)
+	})
+}
+
+// HandleGetAccessTokenRoleSuccessfully creates an HTTP handler at
+// `/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles` on the test handler
+// mux that responds with a single role.
+func HandleGetAccessTokenRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/ce9e07/OS-OAUTH1/access_tokens/6be26a/roles/5ad150", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetAccessTokenRoleOutput)
+	})
+}
+
+// HandleAuthenticateSuccessfully creates an HTTP handler at `/auth/tokens`
+// on the test handler mux that tests authentication with an access token.
+func HandleAuthenticateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, AuthenticateRequest)
+		testAuthorization(t, r, oauth1.SignOpts{
+			ConsumerKey:    "7fea2d",
+			ConsumerSecret: "4d2d5e",
+			Token:          "accd36",
+			TokenSecret:    "aa47da",
+			Timestamp:      Timestamp,
+			Nonce:          Nonce,
+		})
+
+		w.Header().Set("X-Subject-Token", "8f1ba8a7b5d54b3f9b5e6d6b3e8b0c9e")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, AuthenticateOutput)
+	})
+}
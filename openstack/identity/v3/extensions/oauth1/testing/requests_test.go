@@ -0,0 +1,344 @@
+package testing
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/oauth1"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListConsumers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListConsumersSuccessfully(t)
+
+	count := 0
+	err := oauth1.ListConsumers(client.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := oauth1.ExtractConsumers(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedConsumersSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetConsumer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetConsumerSuccessfully(t)
+
+	actual, err := oauth1.GetConsumer(client.ServiceClient(), "7fea2d").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstConsumer, *actual)
+}
+
+func TestCreateConsumer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateConsumerSuccessfully(t)
+
+	createOpts := oauth1.CreateConsumerOpts{
+		Description: "My consumer",
+	}
+
+	actual, err := oauth1.CreateConsumer(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CreatedConsumer, *actual)
+}
+
+func TestUpdateConsumer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateConsumerSuccessfully(t)
+
+	description := "My new consumer"
+	updateOpts := oauth1.UpdateConsumerOpts{
+		Description: &description,
+	}
+
+	actual, err := oauth1.UpdateConsumer(client.ServiceClient(), "7fea2d", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, UpdatedConsumer, *actual)
+}
+
+func TestDeleteConsumer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteConsumerSuccessfully(t)
+
+	res := oauth1.DeleteConsumer(client.ServiceClient(), "7fea2d")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestRequestToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRequestTokenSuccessfully(t)
+
+	requestTokenOpts := oauth1.RequestTokenOpts{
+		ConsumerKey:        "7fea2d",
+		ConsumerSecret:     "4d2d5e",
+		RequestedProjectID: "b9fca3",
+		Timestamp:          Timestamp,
+		Nonce:              Nonce,
+	}
+
+	actual, err := oauth1.RequestToken(client.ServiceClient(), requestTokenOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRequestToken, *actual)
+}
+
+func TestRequestTokenMissingProject(t *testing.T) {
+	requestTokenOpts := oauth1.RequestTokenOpts{
+		ConsumerKey:    "7fea2d",
+		ConsumerSecret: "4d2d5e",
+	}
+
+	_, err := oauth1.RequestToken(client.ServiceClient(), requestTokenOpts).Extract()
+	_, ok := err.(gophercloud.ErrMissingInput)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestAuthorizeToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAuthorizeTokenSuccessfully(t)
+
+	authorizeOpts := oauth1.AuthorizeTokenOpts{
+		Roles: []oauth1.Role{
+			{ID: "a3b29b"},
+			{Name: "member"},
+		},
+	}
+
+	actual, err := oauth1.AuthorizeToken(client.ServiceClient(), "29971f", authorizeOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "8171", actual.Verifier)
+}
+
+func TestAuthorizeTokenEmptyRole(t *testing.T) {
+	authorizeOpts := oauth1.AuthorizeTokenOpts{
+		Roles: []oauth1.Role{{}},
+	}
+
+	_, err := authorizeOpts.ToOAuth1AuthorizeTokenMap()
+	_, ok := err.(gophercloud.ErrMissingInput)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestCreateAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateAccessTokenSuccessfully(t)
+
+	accessTokenOpts := oauth1.CreateAccessTokenOpts{
+		ConsumerKey:        "7fea2d",
+		ConsumerSecret:     "4d2d5e",
+		RequestToken:       "29971f",
+		RequestTokenSecret: "238eb8",
+		Verifier:           "8171",
+		Timestamp:          Timestamp,
+		Nonce:              Nonce,
+	}
+
+	actual, err := oauth1.CreateAccessToken(client.ServiceClient(), accessTokenOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedAccessToken, *actual)
+}
+
+func TestCreateAccessTokenMissingVerifier(t *testing.T) {
+	accessTokenOpts := oauth1.CreateAccessTokenOpts{
+		ConsumerKey:  "7fea2d",
+		RequestToken: "29971f",
+	}
+
+	_, err := oauth1.CreateAccessToken(client.ServiceClient(), accessTokenOpts).Extract()
+	_, ok := err.(gophercloud.ErrMissingInput)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestListAccessTokens(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAccessTokensSuccessfully(t)
+
+	count := 0
+	err := oauth1.ListAccessTokens(client.ServiceClient(), "ce9e07").EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := oauth1.ExtractAccessTokens(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, []oauth1.AccessToken{UserAccessToken}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetAccessTokenSuccessfully(t)
+
+	actual, err := oauth1.GetAccessToken(client.ServiceClient(), "ce9e07", "6be26a").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, UserAccessToken, *actual)
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRevokeAccessTokenSuccessfully(t)
+
+	res := oauth1.RevokeAccessToken(client.ServiceClient(), "ce9e07", "6be26a")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListAccessTokenRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAccessTokenRolesSuccessfully(t)
+
+	count := 0
+	err := oauth1.ListAccessTokenRoles(client.ServiceClient(), "ce9e07", "6be26a").EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := oauth1.ExtractAccessTokenRoles(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedAccessTokenRolesSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetAccessTokenRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetAccessTokenRoleSuccessfully(t)
+
+	actual, err := oauth1.GetAccessTokenRole(client.ServiceClient(), "ce9e07", "6be26a", "5ad150").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, AccessTokenAdminRole, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAuthenticateSuccessfully(t)
+
+	authOptions := oauth1.AuthOptions{
+		ConsumerKey:       "7fea2d",
+		ConsumerSecret:    "4d2d5e",
+		AccessToken:       "accd36",
+		AccessTokenSecret: "aa47da",
+		Timestamp:         Timestamp,
+		Nonce:             Nonce,
+	}
+
+	result := oauth1.Create(client.ServiceClient(), authOptions)
+
+	token, err := result.ExtractToken()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "8f1ba8a7b5d54b3f9b5e6d6b3e8b0c9e", token.ID)
+
+	project, err := result.ExtractProject()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "b9fca3", project.ID)
+
+	methods, err := result.ExtractMethods()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"oauth1"}, methods)
+}
+
+func TestSign(t *testing.T) {
+	// The example of appendix A.5 of the OAuth Core 1.0 specification.
+	actual, err := oauth1.Sign("GET", "http://photos.example.net/photos?file=vacation.jpg&size=original", oauth1.SignOpts{
+		ConsumerKey:    "dpf43f3p2l4k3l03",
+		ConsumerSecret: "kd94hf93k423kf44",
+		Token:          "nnch734d00sl2jdk",
+		TokenSecret:    "pfkkdhi9sl3r4s00",
+		Timestamp:      time.Unix(1191242096, 0),
+		Nonce:          "kllo9940pd9333jh",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, `OAuth oauth_consumer_key="dpf43f3p2l4k3l03", oauth_nonce="kllo9940pd9333jh", oauth_signature="tR3%2BTy81lMeYAr%2FFid0kMTYa%2FWM%3D", oauth_signature_method="HMAC-SHA1", oauth_timestamp="1191242096", oauth_token="nnch734d00sl2jdk", oauth_version="1.0"`, actual)
+}
+
+func TestSignRequestToken(t *testing.T) {
+	actual, err := oauth1.Sign("POST", "https://keystone.example.com:5000/v3/OS-OAUTH1/request_token", oauth1.SignOpts{
+		ConsumerKey:    "7fea2d",
+		ConsumerSecret: "4d2d5e",
+		Callback:       "oob",
+		Timestamp:      time.Unix(1388534400, 0),
+		Nonce:          "f2a1c9e0b7d4",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, `OAuth oauth_callback="oob", oauth_consumer_key="7fea2d", oauth_nonce="f2a1c9e0b7d4", oauth_signature="Nq51%2B1JvoQu3Q0jkZLHPe%2Ft%2F2RI%3D", oauth_signature_method="HMAC-SHA1", oauth_timestamp="1388534400", oauth_version="1.0"`, actual)
+}
+
+func TestSignNormalization(t *testing.T) {
+	// The scheme and host are lowercased, the default port is dropped, the
+	// query parameters are decoded and sorted by name then value, and the
+	// secrets are percent-encoded in the signing key.
+	actual, err := oauth1.Sign("post", "HTTP://Keystone.Example.com:80/v3/a%20b?b=2&a=x+y&a=x%21&c=", oauth1.SignOpts{
+		ConsumerKey:    "key",
+		ConsumerSecret: "s&c/r+t",
+		Token:          "tok",
+		TokenSecret:    "t~s",
+		Verifier:       "8171",
+		Timestamp:      time.Unix(1388534400, 0),
+		Nonce:          "n0nce",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, `OAuth oauth_consumer_key="key", oauth_nonce="n0nce", oauth_signature="DWse8KAaBWf1LWl%2B2SkCfQsGUFM%3D", oauth_signature_method="HMAC-SHA1", oauth_timestamp="1388534400", oauth_token="tok", oauth_verifier="8171", oauth_version="1.0"`, actual)
+}
+
+func TestSignGeneratesNonce(t *testing.T) {
+	opts := oauth1.SignOpts{
+		ConsumerKey: "7fea2d",
+		Timestamp:   time.Unix(1388534400, 0),
+	}
+
+	first, err := oauth1.Sign("POST", "http://keystone.example.com/v3/auth/tokens", opts)
+	th.AssertNoErr(t, err)
+	second, err := oauth1.Sign("POST", "http://keystone.example.com/v3/auth/tokens", opts)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, true, strings.HasPrefix(first, "OAuth "))
+	th.CheckEquals(t, false, first == second)
+}
+
+func TestTokenResultError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/OS-OAUTH1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	accessTokenOpts := oauth1.CreateAccessTokenOpts{
+		ConsumerKey:  "7fea2d",
+		RequestToken: "29971f",
+		Verifier:     "8171",
+	}
+
+	_, err := oauth1.CreateAccessToken(client.ServiceClient(), accessTokenOpts).Extract()
+	_, ok := err.(gophercloud.ErrDefault401)
+	th.CheckEquals(t, true, ok)
+}
@@ -0,0 +1,56 @@
+package oauth1
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	ExtPath          = "OS-OAUTH1"
+	ConsumerPath     = "consumers"
+	RequestTokenPath = "request_token"
+	AuthorizePath    = "authorize"
+	AccessTokenPath  = "access_token"
+	UserPath         = "users"
+	AccessTokensPath = "access_tokens"
+	RolePath         = "roles"
+	AuthPath         = "auth"
+	TokenPath        = "tokens"
+)
+
+func consumersURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, ConsumerPath)
+}
+
+func consumerURL(c *gophercloud.ServiceClient, consumerID string) string {
+	return c.ServiceURL(ExtPath, ConsumerPath, consumerID)
+}
+
+func requestTokenURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, RequestTokenPath)
+}
+
+func authorizeTokenURL(c *gophercloud.ServiceClient, requestTokenID string) string {
+	return c.ServiceURL(ExtPath, AuthorizePath, requestTokenID)
+}
+
+func createAccessTokenURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, AccessTokenPath)
+}
+
+func accessTokensURL(c *gophercloud.ServiceClient, userID string) string {
+	return c.ServiceURL(UserPath, userID, ExtPath, AccessTokensPath)
+}
+
+func accessTokenURL(c *gophercloud.ServiceClient, userID, accessTokenID string) string {
+	return c.ServiceURL(UserPath, userID, ExtPath, AccessTokensPath, accessTokenID)
+}
+
+func accessTokenRolesURL(c *gophercloud.ServiceClient, userID, accessTokenID string) string {
+	return c.ServiceURL(UserPath, userID, ExtPath, AccessTokensPath, accessTokenID, RolePath)
+}
+
+func accessTokenRoleURL(c *gophercloud.ServiceClient, userID, accessTokenID, roleID string) string {
+	return c.ServiceURL(UserPath, userID, ExtPath, AccessTokensPath, accessTokenID, RolePath, roleID)
+}
+
+func authURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(AuthPath, TokenPath)
+}
@@ -0,0 +1,44 @@
+/*
+Package trusts provides information and interaction with the trusts API
+resource (OS-TRUST) for the OpenStack Identity service.
+
+A trust lets a user, the trustor, delegate some of their roles on a project to
+another user, the trustee. The trustee obtains a trust-scoped token by
+authenticating with the TrustID field of gophercloud.AuthOptions, or with a
+tokens.Scope holding the TrustID.
+
+Example to Create a Trust
+
+	expiresAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	remainingUses := 10
+	createOpts := trusts.CreateOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		TrusteeUserID: "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+		ProjectID:     "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+		Roles:         []trusts.Role{{Name: "member"}},
+		Impersonation: true,
+		ExpiresAt:     &expiresAt,
+		RemainingUses: &remainingUses,
+	}
+
+	trust, err := trusts.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Roles of a Trust
+
+	err := trusts.ListRoles(identityClient, trustID).EachPage(func(page pagination.Page) (bool, error) {
+		roles, err := trusts.ExtractRoles(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, role := range roles {
+			fmt.Printf("%+v\n", role)
+		}
+
+		return true, nil
+	})
+*/
+package trusts
@@ -0,0 +1,142 @@
+package trusts
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToTrustCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new trust.
+type CreateOpts struct {
+	// TrustorUserID is the ID of the user delegating the roles. It must be the
+	// user the client's token was issued to.
+	TrustorUserID string `json:"trustor_user_id" required:"true"`
+
+	// TrusteeUserID is the ID of the user the roles are delegated to.
+	TrusteeUserID string `json:"trustee_user_id" required:"true"`
+
+	// ProjectID is the ID of the project the roles are delegated on. It is
+	// required when Roles is not empty.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Roles lists the roles to delegate, each identified by ID or name. They
+	// must be a subset of the trustor's roles on the project.
+	Roles []Role `json:"roles,omitempty"`
+
+	// Impersonation makes trust-scoped tokens of the trustee appear to be
+	// issued to the trustor.
+	Impersonation bool `json:"impersonation"`
+
+	// ExpiresAt is the time at which the trust expires. If nil, the trust
+	// does not expire.
+	ExpiresAt *time.Time `json:"-"`
+
+	// RemainingUses limits the number of tokens the trustee may obtain with
+	// the trust. If nil, the number of uses is unlimited.
+	RemainingUses *int `json:"remaining_uses,omitempty"`
+
+	// AllowRedelegation allows the trustee to delegate the roles further
+	// with a new trust.
+	AllowRedelegation bool `json:"allow_redelegation,omitempty"`
+
+	// RedelegationCount limits the length of the chain of trusts created by
+	// redelegation.
+	RedelegationCount int `json:"redelegation_count,omitempty"`
+}
+
+// ToTrustCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToTrustCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "trust")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		b["trust"].(map[string]interface{})["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339Milli)
+	}
+
+	return b, nil
+}
+
+// Create creates a new trust.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTrustCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToTrustListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// TrustorUserID filters the trusts by the user delegating the roles.
+	TrustorUserID string `q:"trustor_user_id"`
+
+	// TrusteeUserID filters the trusts by the user the roles are delegated to.
+	TrusteeUserID string `q:"trustee_user_id"`
+}
+
+// ToTrustListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTrustListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the trusts. Users other than administrators must filter
+// the trusts by their own user ID as trustor or trustee.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToTrustListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TrustPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a trust, given its ID.
+func Get(client *gophercloud.ServiceClient, trustID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, trustID), &r.Body, nil)
+	return
+}
+
+// Delete revokes a trust. Tokens scoped to the trust are revoked with it.
+func Delete(client *gophercloud.ServiceClient, trustID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, trustID), nil)
+	return
+}
+
+// ListRoles enumerates the roles delegated by a trust.
+func ListRoles(client *gophercloud.ServiceClient, trustID string) pagination.Pager {
+	return pagination.NewPager(client, listRolesURL(client, trustID), func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetRole retrieves a role delegated by a trust. It fails with a 404 error if
+// the trust does not delegate the role.
+func GetRole(client *gophercloud.ServiceClient, trustID, roleID string) (r GetRoleResult) {
+	_, r.Err = client.Get(roleURL(client, trustID, roleID), &r.Body, nil)
+	return
+}
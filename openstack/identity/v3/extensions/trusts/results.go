@@ -0,0 +1,189 @@
+package trusts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Role is a role delegated by a trust.
+type Role struct {
+	// ID is the ID of the role.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the role.
+	Name string `json:"name,omitempty"`
+}
+
+// Trust represents a delegation of roles from a trustor to a trustee.
+type Trust struct {
+	// ID is the ID of the trust.
+	ID string `json:"id"`
+
+	// TrustorUserID is the ID of the user delegating the roles.
+	TrustorUserID string `json:"trustor_user_id"`
+
+	// TrusteeUserID is the ID of the user the roles are delegated to.
+	TrusteeUserID string `json:"trustee_user_id"`
+
+	// ProjectID is the ID of the project the roles are delegated on.
+	ProjectID string `json:"project_id"`
+
+	// Roles lists the roles delegated by the trust.
+	Roles []Role `json:"roles"`
+
+	// Impersonation indicates whether trust-scoped tokens appear to be issued
+	// to the trustor.
+	Impersonation bool `json:"impersonation"`
+
+	// ExpiresAt is the time at which the trust expires. It is the zero time if
+	// the trust does not expire.
+	ExpiresAt time.Time `json:"-"`
+
+	// RemainingUses is the number of tokens the trustee may still obtain with
+	// the trust, or nil if it is unlimited.
+	RemainingUses *int `json:"remaining_uses"`
+
+	// AllowRedelegation indicates whether the trustee may delegate the roles
+	// further.
+	AllowRedelegation bool `json:"allow_redelegation"`
+
+	// RedelegationCount is the remaining length of the chain of trusts that
+	// may be created by redelegation.
+	RedelegationCount int `json:"redelegation_count"`
+
+	// RedelegatedTrustID is the ID of the trust this trust was redelegated
+	// from, if any.
+	RedelegatedTrustID string `json:"redelegated_trust_id"`
+}
+
+func (t *Trust) UnmarshalJSON(b []byte) error {
+	type tmp Trust
+	var s struct {
+		tmp
+		ExpiresAt string `json:"expires_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*t = Trust(s.tmp)
+
+	if s.ExpiresAt != "" {
+		t.ExpiresAt, err = time.Parse(gophercloud.RFC3339Milli, s.ExpiresAt)
+	}
+	return err
+}
+
+type trustResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or CreateResult as a Trust.
+func (r trustResult) Extract() (*Trust, error) {
+	var s struct {
+		Trust *Trust `json:"trust"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Trust, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Trust.
+type CreateResult struct {
+	trustResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Trust.
+type GetResult struct {
+	trustResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GetRoleResult is the response from a GetRole operation. Call its Extract
+// method to interpret it as a Role.
+type GetRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetRoleResult as a Role.
+func (r GetRoleResult) Extract() (*Role, error) {
+	var s struct {
+		Role *Role `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
+}
+
+// TrustPage is a single page of Trust results.
+type TrustPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a TrustPage contains any results.
+func (r TrustPage) IsEmpty() (bool, error) {
+	trusts, err := ExtractTrusts(r)
+	return len(trusts) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r TrustPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractTrusts returns a slice of Trusts contained in a single page of
+// results.
+func ExtractTrusts(r pagination.Page) ([]Trust, error) {
+	var s struct {
+		Trusts []Trust `json:"trusts"`
+	}
+	err := (r.(TrustPage)).ExtractInto(&s)
+	return s.Trusts, err
+}
+
+// RolePage is a single page of the Roles delegated by a trust.
+type RolePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a RolePage contains any results.
+func (r RolePage) IsEmpty() (bool, error) {
+	roles, err := ExtractRoles(r)
+	return len(roles) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r RolePage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractRoles returns a slice of Roles contained in a single page of
+// results.
+func ExtractRoles(r pagination.Page) ([]Role, error) {
+	var s struct {
+		Roles []Role `json:"roles"`
+	}
+	err := (r.(RolePage)).ExtractInto(&s)
+	return s.Roles, err
+}
@@ -0,0 +1,249 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// TrustID is the ID of the trust in the test fixtures.
+const TrustID = "987fe7"
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+	"trust": {
+		"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+		"trustee_user_id": "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+		"project_id": "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+		"roles": [
+			{"name": "member"}
+		],
+		"impersonation": true,
+		"expires_at": "2027-01-01T00:00:00Z",
+		"remaining_uses": 10
+	}
+}
+`
+
+// GetOutput provides a Get and Create result.
+const GetOutput = `
+{
+	"trust": {
+		"id": "987fe7",
+		"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+		"trustee_user_id": "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+		"project_id": "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+		"roles": [
+			{"id": "c9a53c7a5f3e4b3c8f2c6b1a0d9e8f7a", "name": "member"}
+		],
+		"roles_links": {"next": null, "previous": null},
+		"impersonation": true,
+		"expires_at": "2027-01-01T00:00:00.000000Z",
+		"remaining_uses": 10,
+		"allow_redelegation": false,
+		"redelegation_count": 0,
+		"redelegated_trust_id": null,
+		"links": {
+			"self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe7"
+		}
+	}
+}
+`
+
+// ListOutput provides a single page of Trust results.
+const ListOutput = `
+{
+	"links": {
+		"self": "http://example.com/identity/v3/OS-TRUST/trusts",
+		"previous": null,
+		"next": null
+	},
+	"trusts": [
+		{
+			"id": "987fe7",
+			"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+			"trustee_user_id": "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+			"project_id": "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+			"impersonation": true,
+			"expires_at": "2027-01-01T00:00:00.000000Z",
+			"remaining_uses": 10,
+			"links": {
+				"self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe7"
+			}
+		},
+		{
+			"id": "ea8e4b",
+			"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+			"trustee_user_id": "8f4e6b2c1d3a4e5f9b7c6d5e4f3a2b1c",
+			"project_id": "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+			"impersonation": false,
+			"expires_at": null,
+			"remaining_uses": null,
+			"links": {
+				"self": "http://example.com/identity/v3/OS-TRUST/trusts/ea8e4b"
+			}
+		}
+	]
+}
+`
+
+// ListRolesOutput provides a single page of the Roles of a trust.
+const ListRolesOutput = `
+{
+	"links": {
+		"self": "http://example.com/identity/v3/OS-TRUST/trusts/987fe7/roles",
+		"previous": null,
+		"next": null
+	},
+	"roles": [
+		{
+			"id": "c9a53c7a5f3e4b3c8f2c6b1a0d9e8f7a",
+			"name": "member",
+			"links": {
+				"self": "http://example.com/identity/v3/roles/c9a53c7a5f3e4b3c8f2c6b1a0d9e8f7a"
+			}
+		}
+	]
+}
+`
+
+// GetRoleOutput provides a GetRole result.
+const GetRoleOutput = `
+{
+	"role": {
+		"id": "c9a53c7a5f3e4b3c8f2c6b1a0d9e8f7a",
+		"name": "member",
+		"links": {
+			"self": "http://example.com/identity/v3/roles/c9a53c7a5f3e4b3c8f2c6b1a0d9e8f7a"
+		}
+	}
+}
+`
+
+var remainingUses = 10
+
+// MemberRole is the role delegated by the trust in the test fixtures.
+var MemberRole = trusts.Role{
+	ID:   "c9a53c7a5f3e4b3c8f2c6b1a0d9e8f7a",
+	Name: "member",
+}
+
+// ExpectedTrust is the trust returned by GetOutput.
+var ExpectedTrust = trusts.Trust{
+	ID:            "987fe7",
+	TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	TrusteeUserID: "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+	ProjectID:     "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+	Roles:         []trusts.Role{MemberRole},
+	Impersonation: true,
+	ExpiresAt:     time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+	RemainingUses: &remainingUses,
+}
+
+// ExpectedTrustsSlice is the slice of trusts expected to be returned from
+// ListOutput.
+var ExpectedTrustsSlice = []trusts.Trust{
+	{
+		ID:            "987fe7",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		TrusteeUserID: "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+		ProjectID:     "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+		Impersonation: true,
+		ExpiresAt:     time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		RemainingUses: &remainingUses,
+	},
+	{
+		ID:            "ea8e4b",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		TrusteeUserID: "8f4e6b2c1d3a4e5f9b7c6d5e4f3a2b1c",
+		ProjectID:     "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+	},
+}
+
+// HandleCreateTrustSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts` on the test handler mux that tests trust creation.
+func HandleCreateTrustSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleListTrustsSuccessfully creates an HTTP handler at `/OS-TRUST/trusts`
+// on the test handler mux that responds with a list of two trusts.
+func HandleListTrustsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetTrustSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts/987fe7` on the test handler mux that responds with a
+// single trust.
+func HandleGetTrustSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/"+TrustID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleDeleteTrustSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts/987fe7` on the test handler mux that tests trust
+// deletion.
+func HandleDeleteTrustSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/"+TrustID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListTrustRolesSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts/987fe7/roles` on the test handler mux that responds with
+// the roles of the trust.
+func HandleListTrustRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/"+TrustID+"/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListRolesOutput)
+	})
+}
+
+// HandleGetTrustRoleSuccessfully creates an HTTP handler at
+// `/OS-TRUST/trusts/987fe7/roles/{role_id}` on the test handler mux that
+// responds with a single role.
+func HandleGetTrustRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/"+TrustID+"/roles/"+MemberRole.ID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetRoleOutput)
+	})
+}
@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreateTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrustSuccessfully(t)
+
+	expiresAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	remainingUses := 10
+	createOpts := trusts.CreateOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		TrusteeUserID: "3b2a3b7c5f2d4c4e9f7a6b5c4d3e2f1a",
+		ProjectID:     "4a6c8f2e3b1d4e5f9a7b6c5d4e3f2a1b",
+		Roles:         []trusts.Role{{Name: "member"}},
+		Impersonation: true,
+		ExpiresAt:     &expiresAt,
+		RemainingUses: &remainingUses,
+	}
+
+	actual, err := trusts.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedTrust, *actual)
+}
+
+func TestListTrusts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrustsSuccessfully(t)
+
+	count := 0
+	listOpts := trusts.ListOpts{TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3"}
+	err := trusts.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := trusts.ExtractTrusts(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedTrustsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrustSuccessfully(t)
+
+	actual, err := trusts.Get(client.ServiceClient(), TrustID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedTrust, *actual)
+}
+
+func TestDeleteTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteTrustSuccessfully(t)
+
+	res := trusts.Delete(client.ServiceClient(), TrustID)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListTrustRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrustRolesSuccessfully(t)
+
+	count := 0
+	err := trusts.ListRoles(client.ServiceClient(), TrustID).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := trusts.ExtractRoles(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, []trusts.Role{MemberRole}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetTrustRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrustRoleSuccessfully(t)
+
+	actual, err := trusts.GetRole(client.ServiceClient(), TrustID, MemberRole.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, MemberRole, *actual)
+}
@@ -0,0 +1,25 @@
+package trusts
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	ExtPath   = "OS-TRUST"
+	TrustPath = "trusts"
+	RolePath  = "roles"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(ExtPath, TrustPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, trustID string) string {
+	return c.ServiceURL(ExtPath, TrustPath, trustID)
+}
+
+func listRolesURL(c *gophercloud.ServiceClient, trustID string) string {
+	return c.ServiceURL(ExtPath, TrustPath, trustID, RolePath)
+}
+
+func roleURL(c *gophercloud.ServiceClient, trustID, roleID string) string {
+	return c.ServiceURL(ExtPath, TrustPath, trustID, RolePath, roleID)
+}
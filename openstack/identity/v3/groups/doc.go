@@ -0,0 +1,35 @@
+/*
+Package groups provides information and interaction with the groups API
+resource for the OpenStack Identity service.
+
+The members of a group are managed with the ListInGroup, AddToGroup,
+RemoveFromGroup and IsMemberOfGroup functions of the users package. Roles
+granted to a group apply to all its members; roles.ListAssignments with
+Effective set lists the assignments users receive through their groups.
+
+Example to Create a Group
+
+	createOpts := groups.CreateOpts{
+		Name:        "operators",
+		DomainID:    "default",
+		Description: "Operators of the CI infrastructure",
+	}
+
+	group, err := groups.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Look up a Group by Name and Add a User to it
+
+	groupID, err := groups.IDFromName(identityClient, "operators", "default")
+	if err != nil {
+		panic(err)
+	}
+
+	err = users.AddToGroup(identityClient, groupID, userID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
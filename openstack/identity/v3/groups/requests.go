@@ -0,0 +1,140 @@
+package groups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// DomainID filters the groups by the domain they belong to.
+	DomainID string `q:"domain_id"`
+
+	// Name filters the groups by name.
+	Name string `q:"name"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the groups.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a group, given its ID.
+func Get(client *gophercloud.ServiceClient, groupID string) (r GetResult) {
+	_, r.Err = client.Get(groupURL(client, groupID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new group.
+type CreateOpts struct {
+	// Name is the name of the group. It must be unique within its domain.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain of the group. It defaults to the
+	// domain of the client's token.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is a description of the group.
+	Description string `json:"description,omitempty"`
+}
+
+// ToGroupCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "group")
+}
+
+// Create creates a new group.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a group to update.
+type UpdateOpts struct {
+	// Name is the new name of the group.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the group. Set it to a pointer
+	// to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToGroupUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "group")
+}
+
+// Update modifies the attributes of a group.
+func Update(client *gophercloud.ServiceClient, groupID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(groupURL(client, groupID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a group.
+func Delete(client *gophercloud.ServiceClient, groupID string) (r DeleteResult) {
+	_, r.Err = client.Delete(groupURL(client, groupID), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a group's ID given its
+// name. Group names are only unique within a domain, so the domain of the
+// group should be given by domainID. If domainID is empty, groups of every
+// domain the client can see are considered.
+func IDFromName(client *gophercloud.ServiceClient, name, domainID string) (string, error) {
+	return utils.IDFromName(name, utils.FindOpts{
+		ResourceType: "group",
+		Key:          "groups",
+		List: func(name string) pagination.Pager {
+			return List(client, ListOpts{Name: name, DomainID: domainID})
+		},
+	})
+}
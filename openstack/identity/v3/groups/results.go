@@ -0,0 +1,93 @@
+package groups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Group represents an OpenStack Identity group.
+type Group struct {
+	// ID is the ID of the group.
+	ID string `json:"id"`
+
+	// Name is the name of the group.
+	Name string `json:"name"`
+
+	// DomainID is the ID of the domain the group belongs to.
+	DomainID string `json:"domain_id"`
+
+	// Description is the description of the group.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the group.
+	Links map[string]interface{} `json:"links"`
+}
+
+type groupResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Group.
+func (r groupResult) Extract() (*Group, error) {
+	var s struct {
+		Group *Group `json:"group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Group, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Group.
+type GetResult struct {
+	groupResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Group.
+type CreateResult struct {
+	groupResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Group.
+type UpdateResult struct {
+	groupResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GroupPage is a single page of Group results.
+type GroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a GroupPage contains any results.
+func (r GroupPage) IsEmpty() (bool, error) {
+	groups, err := ExtractGroups(r)
+	return len(groups) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r GroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractGroups returns a slice of Groups contained in a single page of
+// results.
+func ExtractGroups(r pagination.Page) ([]Group, error) {
+	var s struct {
+		Groups []Group `json:"groups"`
+	}
+	err := (r.(GroupPage)).ExtractInto(&s)
+	return s.Groups, err
+}
@@ -0,0 +1,213 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Group results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/groups"
+    },
+    "groups": [
+        {
+            "domain_id": "default",
+            "id": "2844b2",
+            "name": "Developers",
+            "description": "Developers of the CI infrastructure",
+            "links": {
+                "self": "http://example.com/identity/v3/groups/2844b2"
+            }
+        },
+        {
+            "domain_id": "1789d1",
+            "id": "9fe1d3",
+            "name": "Operators",
+            "description": "",
+            "links": {
+                "self": "http://example.com/identity/v3/groups/9fe1d3"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "group": {
+        "domain_id": "1789d1",
+        "id": "9fe1d3",
+        "name": "Operators",
+        "description": "",
+        "links": {
+            "self": "http://example.com/identity/v3/groups/9fe1d3"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "group": {
+        "domain_id": "1789d1",
+        "name": "Operators"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "group": {
+        "description": "Operators of the CI infrastructure"
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "group": {
+        "domain_id": "1789d1",
+        "id": "9fe1d3",
+        "name": "Operators",
+        "description": "Operators of the CI infrastructure",
+        "links": {
+            "self": "http://example.com/identity/v3/groups/9fe1d3"
+        }
+    }
+}
+`
+
+// FirstGroup is the first group in the List request.
+var FirstGroup = groups.Group{
+	DomainID:    "default",
+	ID:          "2844b2",
+	Name:        "Developers",
+	Description: "Developers of the CI infrastructure",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/groups/2844b2",
+	},
+}
+
+// SecondGroup is the second group in the List request.
+var SecondGroup = groups.Group{
+	DomainID:    "1789d1",
+	ID:          "9fe1d3",
+	Name:        "Operators",
+	Description: "",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/groups/9fe1d3",
+	},
+}
+
+// SecondGroupUpdated is how SecondGroup should look after an Update.
+var SecondGroupUpdated = groups.Group{
+	DomainID:    "1789d1",
+	ID:          "9fe1d3",
+	Name:        "Operators",
+	Description: "Operators of the CI infrastructure",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/groups/9fe1d3",
+	},
+}
+
+// ExpectedGroupsSlice is the slice of groups expected to be returned from
+// ListOutput.
+var ExpectedGroupsSlice = []groups.Group{FirstGroup, SecondGroup}
+
+// HandleListGroupsSuccessfully creates an HTTP handler at `/groups` on the
+// test handler mux that responds with a list of two groups.
+func HandleListGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleFindGroupSuccessfully creates an HTTP handler at `/groups` on the
+// test handler mux that responds with the groups matching the name and
+// domain filters.
+func HandleFindGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":      "Operators",
+			"domain_id": "1789d1",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"groups": [{"id": "9fe1d3", "name": "Operators", "domain_id": "1789d1"}], "links": {"next": null}}`)
+	})
+}
+
+// HandleGetGroupSuccessfully creates an HTTP handler at `/groups/9fe1d3` on
+// the test handler mux that responds with a single group.
+func HandleGetGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateGroupSuccessfully creates an HTTP handler at `/groups` on the
+// test handler mux that tests group creation.
+func HandleCreateGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateGroupSuccessfully creates an HTTP handler at `/groups/9fe1d3`
+// on the test handler mux that tests group updates.
+func HandleUpdateGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteGroupSuccessfully creates an HTTP handler at `/groups/9fe1d3`
+// on the test handler mux that tests group deletion.
+func HandleDeleteGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
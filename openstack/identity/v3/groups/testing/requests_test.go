@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListGroupsSuccessfully(t)
+
+	count := 0
+	err := groups.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := groups.ExtractGroups(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedGroupsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListGroupsOpts(t *testing.T) {
+	listOpts := groups.ListOpts{
+		DomainID: "1789d1",
+		Name:     "Operators",
+	}
+
+	query, err := listOpts.ToGroupListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?domain_id=1789d1&name=Operators", query)
+}
+
+func TestGetGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetGroupSuccessfully(t)
+
+	actual, err := groups.Get(client.ServiceClient(), "9fe1d3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondGroup, *actual)
+}
+
+func TestCreateGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateGroupSuccessfully(t)
+
+	createOpts := groups.CreateOpts{
+		Name:     "Operators",
+		DomainID: "1789d1",
+	}
+
+	actual, err := groups.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondGroup, *actual)
+}
+
+func TestCreateGroupRequiresName(t *testing.T) {
+	res := groups.Create(client.ServiceClient(), groups.CreateOpts{DomainID: "1789d1"})
+	if res.Err == nil {
+		t.Fatal("expected error but call succeeded")
+	}
+}
+
+func TestUpdateGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateGroupSuccessfully(t)
+
+	description := "Operators of the CI infrastructure"
+	updateOpts := groups.UpdateOpts{
+		Description: &description,
+	}
+
+	actual, err := groups.Update(client.ServiceClient(), "9fe1d3", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondGroupUpdated, *actual)
+}
+
+func TestDeleteGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteGroupSuccessfully(t)
+
+	res := groups.Delete(client.ServiceClient(), "9fe1d3")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFindGroupSuccessfully(t)
+
+	id, err := groups.IDFromName(client.ServiceClient(), "Operators", "1789d1")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "9fe1d3", id)
+}
@@ -0,0 +1,11 @@
+package groups
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("groups")
+}
+
+func groupURL(client *gophercloud.ServiceClient, groupID string) string {
+	return client.ServiceURL("groups", groupID)
+}
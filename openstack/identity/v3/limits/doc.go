@@ -0,0 +1,75 @@
+/*
+Package limits provides information and interaction with the limits API
+resource for the OpenStack Identity service.
+
+Limits override, for a project or domain, the default limits of resources
+registered with the registeredlimits package. Services taking part in unified
+limits enforce them instead of their own quotas.
+
+Example to Get the Enforcement Model
+
+	model, err := limits.GetEnforcementModel(identityClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if model.Name == limits.StrictTwoLevelModel {
+		fmt.Println("limits of parent projects include their children")
+	}
+
+Example to List the Limits of a Project
+
+	listOpts := limits.ListOpts{
+		ProjectID: "3a705b9f56bb439381b43c4fe59dccce",
+	}
+
+	allPages, err := limits.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLimits, err := limits.ExtractLimits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, limit := range allLimits {
+		fmt.Printf("%s: %d\n", limit.ResourceName, limit.ResourceLimit)
+	}
+
+Example to Create Limits
+
+	createOpts := limits.BatchCreateOpts{
+		{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "cores",
+			ResourceLimit: 64,
+		},
+	}
+
+	createdLimits, err := limits.BatchCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Limit
+
+	resourceLimit := 128
+	updateOpts := limits.UpdateOpts{
+		ResourceLimit: &resourceLimit,
+	}
+
+	limit, err := limits.Update(identityClient, limitID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Limit
+
+	err := limits.Delete(identityClient, limitID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package limits
@@ -0,0 +1,179 @@
+package limits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// GetEnforcementModel retrieves the limit enforcement model of the
+// deployment, which determines how limits apply to project hierarchies.
+func GetEnforcementModel(client *gophercloud.ServiceClient) (r EnforcementModelResult) {
+	_, r.Err = client.Get(enforcementModelURL(client), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToLimitListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// ServiceID filters the limits by service.
+	ServiceID string `q:"service_id"`
+
+	// RegionID filters the limits by region.
+	RegionID string `q:"region_id"`
+
+	// ResourceName filters the limits by the name of the resource they limit,
+	// e.g. "cores".
+	ResourceName string `q:"resource_name"`
+
+	// ProjectID filters the limits by project.
+	ProjectID string `q:"project_id"`
+
+	// DomainID filters the limits by domain.
+	DomainID string `q:"domain_id"`
+}
+
+// ToLimitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLimitListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the limits.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToLimitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return LimitPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single limit, by ID.
+func Get(client *gophercloud.ServiceClient, limitID string) (r GetResult) {
+	_, r.Err = client.Get(limitURL(client, limitID), &r.Body, nil)
+	return
+}
+
+// CreateOpts specifies the attributes of a new limit. A registered limit of
+// the same service, region and resource must exist.
+type CreateOpts struct {
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id" required:"true"`
+
+	// ProjectID is the ID of the project the limit applies to. Exactly one of
+	// ProjectID and DomainID must be set.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// DomainID is the ID of the domain the limit applies to.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// RegionID is the ID of the region the limit applies to.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ResourceName is the name of the resource to limit, e.g. "cores".
+	ResourceName string `json:"resource_name" required:"true"`
+
+	// ResourceLimit is the limit of the resource.
+	ResourceLimit int `json:"resource_limit"`
+
+	// Description is a description of the limit.
+	Description string `json:"description,omitempty"`
+}
+
+// ToMap formats a CreateOpts into a limit of a create request.
+func (opts CreateOpts) ToMap() (map[string]interface{}, error) {
+	if (opts.ProjectID == "") == (opts.DomainID == "") {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "ProjectID/DomainID"
+		err.Info = "Exactly one of ProjectID and DomainID must be provided"
+		return nil, err
+	}
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// BatchCreateOptsBuilder allows extensions to add additional parameters to
+// the BatchCreate request.
+type BatchCreateOptsBuilder interface {
+	ToLimitsCreateMap() (map[string]interface{}, error)
+}
+
+// BatchCreateOpts lists the limits to create.
+type BatchCreateOpts []CreateOpts
+
+// ToLimitsCreateMap formats a BatchCreateOpts into a create request.
+func (opts BatchCreateOpts) ToLimitsCreateMap() (map[string]interface{}, error) {
+	limits := make([]map[string]interface{}, len(opts))
+	for i, limit := range opts {
+		b, err := limit.ToMap()
+		if err != nil {
+			return nil, err
+		}
+		limits[i] = b
+	}
+	return map[string]interface{}{"limits": limits}, nil
+}
+
+// BatchCreate creates one or more limits. Either all of them are created, or
+// none is.
+func BatchCreate(client *gophercloud.ServiceClient, opts BatchCreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLimitsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToLimitUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a limit to update.
+type UpdateOpts struct {
+	// ResourceLimit is the new limit of the resource.
+	ResourceLimit *int `json:"resource_limit,omitempty"`
+
+	// Description is the new description of the limit. Set it to a pointer
+	// to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToLimitUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToLimitUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "limit")
+}
+
+// Update modifies the attributes of a limit.
+func Update(client *gophercloud.ServiceClient, limitID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLimitUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(limitURL(client, limitID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a limit. The registered limit of the resource then applies
+// to the project again.
+func Delete(client *gophercloud.ServiceClient, limitID string) (r DeleteResult) {
+	_, r.Err = client.Delete(limitURL(client, limitID), nil)
+	return
+}
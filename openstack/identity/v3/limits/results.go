@@ -0,0 +1,155 @@
+package limits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// The limit enforcement models of the Identity service.
+const (
+	// FlatModel enforces the limit of each project independently of the
+	// other projects of its hierarchy.
+	FlatModel = "flat"
+
+	// StrictTwoLevelModel enforces the limit of a parent project on the sum
+	// of its usage and the usage of its children, and allows at most two
+	// levels of projects.
+	StrictTwoLevelModel = "strict_two_level"
+)
+
+// EnforcementModel describes the limit enforcement model of the deployment.
+type EnforcementModel struct {
+	// Name is the name of the model, e.g. FlatModel.
+	Name string `json:"name"`
+
+	// Description is the description of the model.
+	Description string `json:"description"`
+}
+
+// EnforcementModelResult is the response from a GetEnforcementModel
+// operation. Call its Extract method to interpret it as an EnforcementModel.
+type EnforcementModelResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an EnforcementModelResult as an EnforcementModel.
+func (r EnforcementModelResult) Extract() (*EnforcementModel, error) {
+	var s struct {
+		Model *EnforcementModel `json:"model"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Model, err
+}
+
+// Limit is the limit of a resource of a service for a project or domain. It
+// overrides the registered limit of the resource.
+type Limit struct {
+	// ID is the ID of the limit.
+	ID string `json:"id"`
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id"`
+
+	// ProjectID is the ID of the project the limit applies to. It is empty
+	// for domain limits.
+	ProjectID string `json:"project_id"`
+
+	// DomainID is the ID of the domain the limit applies to. It is empty for
+	// project limits.
+	DomainID string `json:"domain_id"`
+
+	// RegionID is the ID of the region the limit applies to. It is empty if
+	// it applies to all of the regions.
+	RegionID string `json:"region_id"`
+
+	// ResourceName is the name of the limited resource, e.g. "cores".
+	ResourceName string `json:"resource_name"`
+
+	// ResourceLimit is the limit of the resource.
+	ResourceLimit int `json:"resource_limit"`
+
+	// Description is the description of the limit.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the limit.
+	Links map[string]interface{} `json:"links"`
+}
+
+type limitResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or UpdateResult as a Limit.
+func (r limitResult) Extract() (*Limit, error) {
+	var s struct {
+		Limit *Limit `json:"limit"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Limit, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Limit.
+type GetResult struct {
+	limitResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Limit.
+type UpdateResult struct {
+	limitResult
+}
+
+// CreateResult is the response from a BatchCreate operation. Call its
+// Extract method to interpret it as a slice of Limits.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a slice of Limits, in the order they
+// were requested.
+func (r CreateResult) Extract() ([]Limit, error) {
+	var s struct {
+		Limits []Limit `json:"limits"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Limits, err
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LimitPage is a single page of Limit results.
+type LimitPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a LimitPage contains any results.
+func (r LimitPage) IsEmpty() (bool, error) {
+	limits, err := ExtractLimits(r)
+	return len(limits) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r LimitPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractLimits returns a slice of Limits contained in a single page of
+// results.
+func ExtractLimits(r pagination.Page) ([]Limit, error) {
+	var s struct {
+		Limits []Limit `json:"limits"`
+	}
+	err := (r.(LimitPage)).ExtractInto(&s)
+	return s.Limits, err
+}
@@ -0,0 +1,246 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/limits"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// GetEnforcementModelOutput provides a GetEnforcementModel result.
+const GetEnforcementModelOutput = `
+{
+    "model": {
+        "description": "Limit enforcement and validation does not take project hierarchy into consideration.",
+        "name": "flat"
+    }
+}
+`
+
+// ListOutput provides a single page of Limit results.
+const ListOutput = `
+{
+    "links": {
+        "self": "http://example.com/identity/v3/limits",
+        "previous": null,
+        "next": null
+    },
+    "limits": [
+        {
+            "id": "25a04c7a065c430590881c646cdcdd58",
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "domain_id": null,
+            "region_id": null,
+            "resource_name": "cores",
+            "resource_limit": 64,
+            "description": "Cores of the batch project",
+            "links": {
+                "self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+            }
+        },
+        {
+            "id": "3229b3849f584faea483d6851f7aab05",
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "domain_id": null,
+            "region_id": "RegionOne",
+            "resource_name": "ram_mb",
+            "resource_limit": 131072,
+            "description": null,
+            "links": {
+                "self": "http://example.com/identity/v3/limits/3229b3849f584faea483d6851f7aab05"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "limit": {
+        "id": "25a04c7a065c430590881c646cdcdd58",
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+        "domain_id": null,
+        "region_id": null,
+        "resource_name": "cores",
+        "resource_limit": 64,
+        "description": "Cores of the batch project",
+        "links": {
+            "self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a BatchCreate request.
+const CreateRequest = `
+{
+    "limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "resource_name": "cores",
+            "resource_limit": 64,
+            "description": "Cores of the batch project"
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "region_id": "RegionOne",
+            "resource_name": "ram_mb",
+            "resource_limit": 131072
+        }
+    ]
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "limit": {
+        "resource_limit": 128,
+        "description": ""
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "limit": {
+        "id": "25a04c7a065c430590881c646cdcdd58",
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+        "domain_id": null,
+        "region_id": null,
+        "resource_name": "cores",
+        "resource_limit": 128,
+        "description": "",
+        "links": {
+            "self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+        }
+    }
+}
+`
+
+// FirstLimit is the first limit in the List request.
+var FirstLimit = limits.Limit{
+	ID:            "25a04c7a065c430590881c646cdcdd58",
+	ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+	ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+	ResourceName:  "cores",
+	ResourceLimit: 64,
+	Description:   "Cores of the batch project",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/limits/25a04c7a065c430590881c646cdcdd58",
+	},
+}
+
+// SecondLimit is the second limit in the List request.
+var SecondLimit = limits.Limit{
+	ID:            "3229b3849f584faea483d6851f7aab05",
+	ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+	ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+	RegionID:      "RegionOne",
+	ResourceName:  "ram_mb",
+	ResourceLimit: 131072,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/limits/3229b3849f584faea483d6851f7aab05",
+	},
+}
+
+// ExpectedLimitsSlice is the slice of limits expected to be returned from
+// ListOutput.
+var ExpectedLimitsSlice = []limits.Limit{FirstLimit, SecondLimit}
+
+// HandleGetEnforcementModelSuccessfully creates an HTTP handler at
+// `/limits/model` on the test handler mux that responds with the flat
+// enforcement model.
+func HandleGetEnforcementModelSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/model", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetEnforcementModelOutput)
+	})
+}
+
+// HandleListLimitsSuccessfully creates an HTTP handler at `/limits` on the
+// test handler mux that responds with a list of two limits.
+func HandleListLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"project_id": "3a705b9f56bb439381b43c4fe59dccce",
+			"service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetLimitSuccessfully creates an HTTP handler at `/limits/25a04c...`
+// on the test handler mux that responds with a single limit.
+func HandleGetLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateLimitsSuccessfully creates an HTTP handler at `/limits` on the
+// test handler mux that tests the creation of limits.
+func HandleCreateLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleUpdateLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c...` on the test handler mux that tests limit updates.
+func HandleUpdateLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c...` on the test handler mux that tests limit deletion.
+func HandleDeleteLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/limits"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGetEnforcementModel(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEnforcementModelSuccessfully(t)
+
+	actual, err := limits.GetEnforcementModel(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, limits.FlatModel, actual.Name)
+}
+
+func TestListLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListLimitsSuccessfully(t)
+
+	count := 0
+	listOpts := limits.ListOpts{
+		ServiceID: "9408080f1970482aa0e38bc2d4ea34b7",
+		ProjectID: "3a705b9f56bb439381b43c4fe59dccce",
+	}
+	err := limits.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := limits.ExtractLimits(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedLimitsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetLimitSuccessfully(t)
+
+	actual, err := limits.Get(client.ServiceClient(), FirstLimit.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstLimit, *actual)
+}
+
+func TestBatchCreateLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateLimitsSuccessfully(t)
+
+	createOpts := limits.BatchCreateOpts{
+		{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "cores",
+			ResourceLimit: 64,
+			Description:   "Cores of the batch project",
+		},
+		{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			RegionID:      "RegionOne",
+			ResourceName:  "ram_mb",
+			ResourceLimit: 131072,
+		},
+	}
+
+	actual, err := limits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedLimitsSlice, actual)
+}
+
+func TestBatchCreateLimitsRequiresOneTarget(t *testing.T) {
+	for _, opts := range []limits.CreateOpts{
+		{ServiceID: "9408080f1970482aa0e38bc2d4ea34b7", ResourceName: "cores"},
+		{ServiceID: "9408080f1970482aa0e38bc2d4ea34b7", ResourceName: "cores", ProjectID: "3a705b", DomainID: "default"},
+	} {
+		_, err := limits.BatchCreateOpts{opts}.ToLimitsCreateMap()
+		if err == nil {
+			t.Fatalf("expected an error for %+v", opts)
+		}
+	}
+}
+
+func TestUpdateLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateLimitSuccessfully(t)
+
+	resourceLimit := 128
+	description := ""
+	updateOpts := limits.UpdateOpts{
+		ResourceLimit: &resourceLimit,
+		Description:   &description,
+	}
+
+	actual, err := limits.Update(client.ServiceClient(), FirstLimit.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 128, actual.ResourceLimit)
+	th.CheckEquals(t, "", actual.Description)
+}
+
+func TestDeleteLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteLimitSuccessfully(t)
+
+	res := limits.Delete(client.ServiceClient(), FirstLimit.ID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,15 @@
+package limits
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("limits")
+}
+
+func limitURL(client *gophercloud.ServiceClient, limitID string) string {
+	return client.ServiceURL("limits", limitID)
+}
+
+func enforcementModelURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("limits", "model")
+}
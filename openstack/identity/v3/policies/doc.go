@@ -0,0 +1,73 @@
+/*
+Package policies provides information and interaction with the policies API
+resource for the OpenStack Identity service, and with the OS-ENDPOINT-POLICY
+extension, which associates policies with endpoints, services and regions.
+
+Example to List Policies
+
+	listOpts := policies.ListOpts{
+		Type: "application/json",
+	}
+
+	allPages, err := policies.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPolicies, err := policies.ExtractPolicies(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, policy := range allPolicies {
+		fmt.Printf("%+v\n", policy)
+	}
+
+Example to Create a Policy
+
+	createOpts := policies.CreateOpts{
+		Blob: `{"compute:get": "rule:admin_or_owner"}`,
+		Type: "application/json",
+	}
+
+	policy, err := policies.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Policy
+
+	policyID := "b49884"
+
+	updateOpts := policies.UpdateOpts{
+		Blob: `{"compute:get": ""}`,
+	}
+
+	policy, err := policies.Update(identityClient, policyID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Policy
+
+	policyID := "b49884"
+	err := policies.Delete(identityClient, policyID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Policy with a Service in a Region
+
+	err := policies.AddServiceInRegion(identityClient, "b49884", "1b501a", "RegionOne").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Policy in Effect for an Endpoint
+
+	policy, err := policies.GetForEndpoint(identityClient, "6fedc0").Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package policies
@@ -0,0 +1,210 @@
+package policies
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToPolicyListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// Type filters the policies by the media type of their blob, e.g.
+	// "application/json".
+	Type string `q:"type"`
+}
+
+// ToPolicyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPolicyListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the policies.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToPolicyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return PolicyPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single policy, by ID.
+func Get(client *gophercloud.ServiceClient, policyID string) (r GetResult) {
+	_, r.Err = client.Get(policyURL(client, policyID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToPolicyCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new policy.
+type CreateOpts struct {
+	// Blob is the serialized policy, e.g. the JSON rules of oslo.policy.
+	Blob string `json:"blob" required:"true"`
+
+	// Type is the media type of the blob, e.g. "application/json".
+	Type string `json:"type" required:"true"`
+}
+
+// ToPolicyCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "policy")
+}
+
+// Create creates a new policy.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToPolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a policy to update.
+type UpdateOpts struct {
+	// Blob is the new serialized policy.
+	Blob string `json:"blob,omitempty"`
+
+	// Type is the new media type of the blob.
+	Type string `json:"type,omitempty"`
+}
+
+// ToPolicyUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToPolicyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "policy")
+}
+
+// Update modifies the attributes of a policy.
+func Update(client *gophercloud.ServiceClient, policyID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(policyURL(client, policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a policy, along with its associations.
+func Delete(client *gophercloud.ServiceClient, policyID string) (r DeleteResult) {
+	_, r.Err = client.Delete(policyURL(client, policyID), nil)
+	return
+}
+
+// AddEndpoint associates a policy with a single endpoint (OS-ENDPOINT-POLICY).
+func AddEndpoint(client *gophercloud.ServiceClient, policyID, endpointID string) (r AssociationResult) {
+	_, r.Err = client.Put(endpointURL(client, policyID, endpointID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CheckEndpoint reports whether a policy is associated with a single
+// endpoint. Associations through the endpoint's service or region are not
+// considered.
+func CheckEndpoint(client *gophercloud.ServiceClient, policyID, endpointID string) (bool, error) {
+	return checkAssociation(client, endpointURL(client, policyID, endpointID))
+}
+
+// RemoveEndpoint removes the association of a policy with a single endpoint.
+func RemoveEndpoint(client *gophercloud.ServiceClient, policyID, endpointID string) (r AssociationResult) {
+	_, r.Err = client.Delete(endpointURL(client, policyID, endpointID), nil)
+	return
+}
+
+// AddService associates a policy with every endpoint of a service.
+func AddService(client *gophercloud.ServiceClient, policyID, serviceID string) (r AssociationResult) {
+	_, r.Err = client.Put(serviceURL(client, policyID, serviceID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CheckService reports whether a policy is associated with a service.
+func CheckService(client *gophercloud.ServiceClient, policyID, serviceID string) (bool, error) {
+	return checkAssociation(client, serviceURL(client, policyID, serviceID))
+}
+
+// RemoveService removes the association of a policy with a service.
+func RemoveService(client *gophercloud.ServiceClient, policyID, serviceID string) (r AssociationResult) {
+	_, r.Err = client.Delete(serviceURL(client, policyID, serviceID), nil)
+	return
+}
+
+// AddServiceInRegion associates a policy with the endpoints of a service in
+// a region and its child regions.
+func AddServiceInRegion(client *gophercloud.ServiceClient, policyID, serviceID, regionID string) (r AssociationResult) {
+	_, r.Err = client.Put(serviceInRegionURL(client, policyID, serviceID, regionID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CheckServiceInRegion reports whether a policy is associated with a
+// service in a region.
+func CheckServiceInRegion(client *gophercloud.ServiceClient, policyID, serviceID, regionID string) (bool, error) {
+	return checkAssociation(client, serviceInRegionURL(client, policyID, serviceID, regionID))
+}
+
+// RemoveServiceInRegion removes the association of a policy with a service
+// in a region.
+func RemoveServiceInRegion(client *gophercloud.ServiceClient, policyID, serviceID, regionID string) (r AssociationResult) {
+	_, r.Err = client.Delete(serviceInRegionURL(client, policyID, serviceID, regionID), nil)
+	return
+}
+
+func checkAssociation(client *gophercloud.ServiceClient, url string) (bool, error) {
+	resp, err := client.Head(url, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode != 404, nil
+}
+
+// ListEndpoints enumerates the endpoints a policy applies to, whether it is
+// associated with them directly, through their service, or through their
+// service and region.
+func ListEndpoints(client *gophercloud.ServiceClient, policyID string) pagination.Pager {
+	return pagination.NewPager(client, listEndpointsURL(client, policyID), func(r pagination.PageResult) pagination.Page {
+		return endpoints.EndpointPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetForEndpoint retrieves the policy in effect for an endpoint. The policy
+// associated with the endpoint itself takes precedence over the one
+// associated with its service in its region or a parent region, which takes
+// precedence over the one associated with its service.
+func GetForEndpoint(client *gophercloud.ServiceClient, endpointID string) (r GetResult) {
+	_, r.Err = client.Get(endpointPolicyURL(client, endpointID), &r.Body, nil)
+	return
+}
@@ -0,0 +1,102 @@
+package policies
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Policy is a serialized policy, e.g. the rules of oslo.policy, stored by the
+// Identity service for other services to retrieve.
+type Policy struct {
+	// ID is the ID of the policy.
+	ID string `json:"id"`
+
+	// Blob is the serialized policy.
+	Blob string `json:"blob"`
+
+	// Type is the media type of the blob.
+	Type string `json:"type"`
+
+	// Links contains referencing links to the policy.
+	Links map[string]interface{} `json:"links"`
+}
+
+type policyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Policy.
+func (r policyResult) Extract() (*Policy, error) {
+	var s struct {
+		Policy *Policy `json:"policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Policy, err
+}
+
+// GetResult is the response from a Get or GetForEndpoint operation. Call its
+// Extract method to interpret it as a Policy.
+type GetResult struct {
+	policyResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Policy.
+type CreateResult struct {
+	policyResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Policy.
+type UpdateResult struct {
+	policyResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssociationResult is the response from an operation that adds or removes
+// an association with an endpoint, a service, or a service in a region. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type AssociationResult struct {
+	gophercloud.ErrResult
+}
+
+// PolicyPage is a single page of Policy results.
+type PolicyPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a PolicyPage contains any results.
+func (r PolicyPage) IsEmpty() (bool, error) {
+	policies, err := ExtractPolicies(r)
+	return len(policies) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r PolicyPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractPolicies returns a slice of Policies contained in a single page of
+// results.
+func ExtractPolicies(r pagination.Page) ([]Policy, error) {
+	var s struct {
+		Policies []Policy `json:"policies"`
+	}
+	err := (r.(PolicyPage)).ExtractInto(&s)
+	return s.Policies, err
+}
@@ -0,0 +1,303 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/policies"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Policy results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/policies"
+    },
+    "policies": [
+        {
+            "blob": "{\"compute:get\": \"\"}",
+            "id": "b49884",
+            "links": {
+                "self": "http://example.com/identity/v3/policies/b49884"
+            },
+            "type": "application/json"
+        },
+        {
+            "blob": "compute:get: rule:admin_or_owner",
+            "id": "5a66a4",
+            "links": {
+                "self": "http://example.com/identity/v3/policies/5a66a4"
+            },
+            "type": "application/x-yaml"
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "policy": {
+        "blob": "{\"compute:get\": \"\"}",
+        "id": "b49884",
+        "links": {
+            "self": "http://example.com/identity/v3/policies/b49884"
+        },
+        "type": "application/json"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "policy": {
+        "blob": "{\"compute:get\": \"\"}",
+        "type": "application/json"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "policy": {
+        "blob": "{\"compute:get\": \"rule:admin_or_owner\"}"
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "policy": {
+        "blob": "{\"compute:get\": \"rule:admin_or_owner\"}",
+        "id": "b49884",
+        "links": {
+            "self": "http://example.com/identity/v3/policies/b49884"
+        },
+        "type": "application/json"
+    }
+}
+`
+
+// ListEndpointsOutput provides the endpoints a policy applies to.
+const ListEndpointsOutput = `
+{
+    "endpoints": [
+        {
+            "id": "6fedc0",
+            "interface": "public",
+            "region_id": "RegionOne",
+            "service_id": "1b501a",
+            "url": "https://compute.example.com/v2.1"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/policies/b49884/OS-ENDPOINT-POLICY/endpoints"
+    }
+}
+`
+
+// FirstPolicy is the first Policy in the List request.
+var FirstPolicy = policies.Policy{
+	ID:   "b49884",
+	Blob: `{"compute:get": ""}`,
+	Type: "application/json",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/policies/b49884",
+	},
+}
+
+// SecondPolicy is the second Policy in the List request.
+var SecondPolicy = policies.Policy{
+	ID:   "5a66a4",
+	Blob: "compute:get: rule:admin_or_owner",
+	Type: "application/x-yaml",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/policies/5a66a4",
+	},
+}
+
+// UpdatedPolicy is the Policy returned by an Update request.
+var UpdatedPolicy = policies.Policy{
+	ID:   "b49884",
+	Blob: `{"compute:get": "rule:admin_or_owner"}`,
+	Type: "application/json",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/policies/b49884",
+	},
+}
+
+// ExpectedPoliciesSlice is the slice of policies expected to be returned
+// from ListOutput.
+var ExpectedPoliciesSlice = []policies.Policy{FirstPolicy, SecondPolicy}
+
+// ExpectedEndpointsSlice is the slice of endpoints expected to be returned
+// from ListEndpointsOutput.
+var ExpectedEndpointsSlice = []endpoints.Endpoint{
+	{
+		ID:           "6fedc0",
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "RegionOne",
+		ServiceID:    "1b501a",
+		URL:          "https://compute.example.com/v2.1",
+	},
+}
+
+// HandleListPoliciesSuccessfully creates an HTTP handler at `/policies` on
+// the test handler mux that responds with a list of two policies.
+func HandleListPoliciesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleListPoliciesWithFilterSuccessfully creates an HTTP handler at
+// `/policies` on the test handler mux that responds with the policies of the
+// requested type.
+func HandleListPoliciesWithFilterSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"type": "application/x-yaml"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+			{
+				"links": {
+					"next": null,
+					"previous": null
+				},
+				"policies": [
+					{
+						"blob": "compute:get: rule:admin_or_owner",
+						"id": "5a66a4",
+						"links": {
+							"self": "http://example.com/identity/v3/policies/5a66a4"
+						},
+						"type": "application/x-yaml"
+					}
+				]
+			}
+		`)
+	})
+}
+
+// HandleGetPolicySuccessfully creates an HTTP handler at `/policies/b49884`
+// on the test handler mux that responds with a single policy.
+func HandleGetPolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies/b49884", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreatePolicySuccessfully creates an HTTP handler at `/policies` on
+// the test handler mux that tests policy creation.
+func HandleCreatePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdatePolicySuccessfully creates an HTTP handler at
+// `/policies/b49884` on the test handler mux that tests policy updates.
+func HandleUpdatePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies/b49884", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeletePolicySuccessfully creates an HTTP handler at
+// `/policies/b49884` on the test handler mux that tests policy deletion.
+func HandleDeletePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies/b49884", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListEndpointsSuccessfully creates an HTTP handler at
+// `/policies/b49884/OS-ENDPOINT-POLICY/endpoints` on the test handler mux
+// that responds with the endpoints the policy applies to.
+func HandleListEndpointsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/policies/b49884/OS-ENDPOINT-POLICY/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListEndpointsOutput)
+	})
+}
+
+// HandleGetForEndpointSuccessfully creates an HTTP handler at
+// `/endpoints/6fedc0/OS-ENDPOINT-POLICY/policy` on the test handler mux that
+// responds with the policy in effect for the endpoint.
+func HandleGetForEndpointSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/endpoints/6fedc0/OS-ENDPOINT-POLICY/policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleAssociationSuccessfully creates an HTTP handler at path on the test
+// handler mux that adds, checks and removes an association. HEAD requests
+// are answered with headStatus.
+func HandleAssociationSuccessfully(t *testing.T, path string, headStatus int) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "HEAD":
+			w.WriteHeader(headStatus)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
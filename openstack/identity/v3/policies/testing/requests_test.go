@@ -0,0 +1,194 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/policies"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListPolicies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPoliciesSuccessfully(t)
+
+	count := 0
+	err := policies.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := policies.ExtractPolicies(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedPoliciesSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListPoliciesAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPoliciesSuccessfully(t)
+
+	allPages, err := policies.List(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPoliciesSlice, actual)
+}
+
+func TestListPoliciesWithFilter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPoliciesWithFilterSuccessfully(t)
+
+	listOpts := policies.ListOpts{
+		Type: "application/x-yaml",
+	}
+
+	allPages, err := policies.List(client.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []policies.Policy{SecondPolicy}, actual)
+}
+
+func TestGetPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetPolicySuccessfully(t)
+
+	actual, err := policies.Get(client.ServiceClient(), "b49884").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstPolicy, *actual)
+}
+
+func TestCreatePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreatePolicySuccessfully(t)
+
+	createOpts := policies.CreateOpts{
+		Blob: `{"compute:get": ""}`,
+		Type: "application/json",
+	}
+
+	actual, err := policies.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstPolicy, *actual)
+}
+
+func TestCreatePolicyMissingType(t *testing.T) {
+	createOpts := policies.CreateOpts{
+		Blob: `{"compute:get": ""}`,
+	}
+
+	_, err := createOpts.ToPolicyCreateMap()
+	_, ok := err.(gophercloud.ErrMissingInput)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestUpdatePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdatePolicySuccessfully(t)
+
+	updateOpts := policies.UpdateOpts{
+		Blob: `{"compute:get": "rule:admin_or_owner"}`,
+	}
+
+	actual, err := policies.Update(client.ServiceClient(), "b49884", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, UpdatedPolicy, *actual)
+}
+
+func TestDeletePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeletePolicySuccessfully(t)
+
+	res := policies.Delete(client.ServiceClient(), "b49884")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestEndpointAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAssociationSuccessfully(t, "/policies/b49884/OS-ENDPOINT-POLICY/endpoints/6fedc0", http.StatusNoContent)
+	HandleAssociationSuccessfully(t, "/policies/5a66a4/OS-ENDPOINT-POLICY/endpoints/6fedc0", http.StatusNotFound)
+
+	err := policies.AddEndpoint(client.ServiceClient(), "b49884", "6fedc0").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := policies.CheckEndpoint(client.ServiceClient(), "b49884", "6fedc0")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	ok, err = policies.CheckEndpoint(client.ServiceClient(), "5a66a4", "6fedc0")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, ok)
+
+	err = policies.RemoveEndpoint(client.ServiceClient(), "b49884", "6fedc0").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServiceAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAssociationSuccessfully(t, "/policies/b49884/OS-ENDPOINT-POLICY/services/1b501a", http.StatusNoContent)
+
+	err := policies.AddService(client.ServiceClient(), "b49884", "1b501a").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := policies.CheckService(client.ServiceClient(), "b49884", "1b501a")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	err = policies.RemoveService(client.ServiceClient(), "b49884", "1b501a").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServiceInRegionAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAssociationSuccessfully(t, "/policies/b49884/OS-ENDPOINT-POLICY/services/1b501a/regions/RegionOne", http.StatusNoContent)
+
+	err := policies.AddServiceInRegion(client.ServiceClient(), "b49884", "1b501a", "RegionOne").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := policies.CheckServiceInRegion(client.ServiceClient(), "b49884", "1b501a", "RegionOne")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	err = policies.RemoveServiceInRegion(client.ServiceClient(), "b49884", "1b501a", "RegionOne").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListEndpoints(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEndpointsSuccessfully(t)
+
+	allPages, err := policies.ListEndpoints(client.ServiceClient(), "b49884").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := endpoints.ExtractEndpoints(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointsSlice, actual)
+}
+
+func TestGetForEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetForEndpointSuccessfully(t)
+
+	actual, err := policies.GetForEndpoint(client.ServiceClient(), "6fedc0").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstPolicy, *actual)
+}
@@ -0,0 +1,34 @@
+package policies
+
+import "github.com/gophercloud/gophercloud"
+
+// endpointPolicyPath is the path of the OS-ENDPOINT-POLICY extension.
+const endpointPolicyPath = "OS-ENDPOINT-POLICY"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("policies")
+}
+
+func policyURL(client *gophercloud.ServiceClient, policyID string) string {
+	return client.ServiceURL("policies", policyID)
+}
+
+func listEndpointsURL(client *gophercloud.ServiceClient, policyID string) string {
+	return client.ServiceURL("policies", policyID, endpointPolicyPath, "endpoints")
+}
+
+func endpointURL(client *gophercloud.ServiceClient, policyID, endpointID string) string {
+	return client.ServiceURL("policies", policyID, endpointPolicyPath, "endpoints", endpointID)
+}
+
+func serviceURL(client *gophercloud.ServiceClient, policyID, serviceID string) string {
+	return client.ServiceURL("policies", policyID, endpointPolicyPath, "services", serviceID)
+}
+
+func serviceInRegionURL(client *gophercloud.ServiceClient, policyID, serviceID, regionID string) string {
+	return client.ServiceURL("policies", policyID, endpointPolicyPath, "services", serviceID, "regions", regionID)
+}
+
+func endpointPolicyURL(client *gophercloud.ServiceClient, endpointID string) string {
+	return client.ServiceURL("endpoints", endpointID, endpointPolicyPath, "policy")
+}
@@ -0,0 +1,57 @@
+/*
+Package projects provides information and interaction with the projects API
+resource for the OpenStack Identity service.
+
+Example to List Projects
+
+	enabled := true
+	listOpts := projects.ListOpts{
+		DomainID: "default",
+		Enabled:  &enabled,
+		Tags:     "ci,ephemeral",
+	}
+
+	allPages, err := projects.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Projects a Token may be Scoped to
+
+	allPages, err := projects.ListAvailable(identityClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	availableProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Project
+
+	createOpts := projects.CreateOpts{
+		Name:     "ci-1234",
+		DomainID: "default",
+		ParentID: "9a6ab3b3f2d54b2c8fe6b2a3c9de35f1",
+		Tags:     []string{"ci", "ephemeral"},
+	}
+
+	project, err := projects.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Tag a Project
+
+	err := projects.AddTag(identityClient, projectID, "protected").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package projects
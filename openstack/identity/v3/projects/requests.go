@@ -0,0 +1,287 @@
+package projects
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToProjectListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// DomainID filters the projects by the domain they belong to.
+	DomainID string `q:"domain_id"`
+
+	// ParentID filters the projects by their parent project.
+	ParentID string `q:"parent_id"`
+
+	// Name filters the projects by name.
+	Name string `q:"name"`
+
+	// Enabled, if set, filters the projects by whether they are enabled.
+	Enabled *bool
+
+	// IsDomain, if set, filters the projects by whether they act as domains.
+	IsDomain *bool
+
+	// Tags lists tags, separated by commas, that the projects must all have.
+	Tags string `q:"tags"`
+
+	// TagsAny lists tags, separated by commas, of which the projects must
+	// have at least one.
+	TagsAny string `q:"tags-any"`
+
+	// NotTags lists tags, separated by commas, that the projects must not
+	// all have.
+	NotTags string `q:"not-tags"`
+
+	// NotTagsAny lists tags, separated by commas, none of which the projects
+	// may have.
+	NotTagsAny string `q:"not-tags-any"`
+}
+
+// ToProjectListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToProjectListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	// BuildQueryString omits false values, which are meaningful filters here.
+	params := q.Query()
+	if opts.Enabled != nil {
+		params.Set("enabled", strconv.FormatBool(*opts.Enabled))
+	}
+	if opts.IsDomain != nil {
+		params.Set("is_domain", strconv.FormatBool(*opts.IsDomain))
+	}
+	q.RawQuery = params.Encode()
+
+	return q.String(), nil
+}
+
+// List enumerates the projects.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToProjectListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ProjectPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListAvailable enumerates the projects the user of the client's token may
+// scope a token to.
+func ListAvailable(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listAvailableURL(client), func(r pagination.PageResult) pagination.Page {
+		return ProjectPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to
+// the Get request.
+type GetOptsBuilder interface {
+	ToProjectGetQuery() (string, error)
+}
+
+// GetOpts allows you to include the hierarchy of a project in a Get request.
+type GetOpts struct {
+	// ParentsAsList includes the parents of the project, up to the root of
+	// its hierarchy, in the Parents field of the result. Only the parents the
+	// user has access to are included.
+	ParentsAsList bool `q:"parents_as_list"`
+
+	// SubtreeAsList includes all the projects below the project in the
+	// Subtree field of the result. Only the projects the user has access to
+	// are included.
+	SubtreeAsList bool `q:"subtree_as_list"`
+}
+
+// ToProjectGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToProjectGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get retrieves a project, given its ID. opts may be nil.
+func Get(client *gophercloud.ServiceClient, projectID string, opts GetOptsBuilder) (r GetResult) {
+	url := projectURL(client, projectID)
+	if opts != nil {
+		query, err := opts.ToProjectGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToProjectCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new project.
+type CreateOpts struct {
+	// Name is the name of the project. It must be unique within its domain.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain of the project. It defaults to the
+	// domain of the parent project, or to the domain of the client's token.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// ParentID is the ID of the parent project.
+	ParentID string `json:"parent_id,omitempty"`
+
+	// Description is a description of the project.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets whether the project is enabled. It defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// IsDomain creates a project that acts as a domain.
+	IsDomain bool `json:"is_domain,omitempty"`
+
+	// Tags lists the tags of the project.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ToProjectCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToProjectCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "project")
+}
+
+// Create creates a new project.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToProjectCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToProjectUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a project to update.
+type UpdateOpts struct {
+	// Name is the new name of the project.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the project. Set it to a
+	// pointer to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled sets whether the project is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Tags, if not nil, replaces the tags of the project.
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// ToProjectUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToProjectUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "project")
+}
+
+// Update modifies the attributes of a project.
+func Update(client *gophercloud.ServiceClient, projectID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToProjectUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(projectURL(client, projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a project. The project must not have any child projects.
+func Delete(client *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
+	_, r.Err = client.Delete(projectURL(client, projectID), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a project's ID given its
+// name. Project names are only unique within a domain, so the domain of the
+// project should be given by domainID. If domainID is empty, projects of every
+// domain the client can see are considered.
+func IDFromName(client *gophercloud.ServiceClient, name, domainID string) (string, error) {
+	return utils.IDFromName(name, utils.FindOpts{
+		ResourceType: "project",
+		Key:          "projects",
+		List: func(name string) pagination.Pager {
+			return List(client, ListOpts{Name: name, DomainID: domainID})
+		},
+	})
+}
+
+// ListTags retrieves the tags of a project.
+func ListTags(client *gophercloud.ServiceClient, projectID string) (r TagsResult) {
+	_, r.Err = client.Get(tagsURL(client, projectID), &r.Body, nil)
+	return
+}
+
+// ReplaceTags replaces all the tags of a project with tags.
+func ReplaceTags(client *gophercloud.ServiceClient, projectID string, tags []string) (r TagsResult) {
+	b := map[string]interface{}{"tags": tags}
+	_, r.Err = client.Put(tagsURL(client, projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteTags removes all the tags of a project.
+func DeleteTags(client *gophercloud.ServiceClient, projectID string) (r TagResult) {
+	_, r.Err = client.Delete(tagsURL(client, projectID), nil)
+	return
+}
+
+// CheckTag determines whether a project has a tag.
+func CheckTag(client *gophercloud.ServiceClient, projectID, tag string) (bool, error) {
+	resp, err := client.Head(tagURL(client, projectID, tag), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// AddTag adds a tag to a project.
+func AddTag(client *gophercloud.ServiceClient, projectID, tag string) (r TagResult) {
+	_, r.Err = client.Put(tagURL(client, projectID, tag), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// DeleteTag removes a tag from a project.
+func DeleteTag(client *gophercloud.ServiceClient, projectID, tag string) (r TagResult) {
+	_, r.Err = client.Delete(tagURL(client, projectID, tag), nil)
+	return
+}
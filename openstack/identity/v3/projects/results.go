@@ -0,0 +1,165 @@
+package projects
+
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Project represents an OpenStack Identity project.
+type Project struct {
+	// ID is the ID of the project.
+	ID string `json:"id"`
+
+	// Name is the name of the project.
+	Name string `json:"name"`
+
+	// DomainID is the ID of the domain the project belongs to.
+	DomainID string `json:"domain_id"`
+
+	// ParentID is the ID of the parent project. For a top-level project, it
+	// is the ID of its domain.
+	ParentID string `json:"parent_id"`
+
+	// Description is the description of the project.
+	Description string `json:"description"`
+
+	// Enabled indicates whether the project is enabled.
+	Enabled bool `json:"enabled"`
+
+	// IsDomain indicates whether the project acts as a domain.
+	IsDomain bool `json:"is_domain"`
+
+	// Tags lists the tags of the project.
+	Tags []string `json:"tags"`
+
+	// Parents lists the parents of the project, up to the root of its
+	// hierarchy. It is only populated by Get with GetOpts.ParentsAsList.
+	Parents []Project `json:"-"`
+
+	// Subtree lists all the projects below the project. It is only populated
+	// by Get with GetOpts.SubtreeAsList.
+	Subtree []Project `json:"-"`
+
+	// Links contains referencing links to the project.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (p *Project) UnmarshalJSON(b []byte) error {
+	type tmp Project
+	type projectRef struct {
+		Project Project `json:"project"`
+	}
+	var s struct {
+		tmp
+		Parents []projectRef `json:"parents"`
+		Subtree []projectRef `json:"subtree"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*p = Project(s.tmp)
+
+	for _, r := range s.Parents {
+		p.Parents = append(p.Parents, r.Project)
+	}
+	for _, r := range s.Subtree {
+		p.Subtree = append(p.Subtree, r.Project)
+	}
+
+	return nil
+}
+
+type projectResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Project.
+func (r projectResult) Extract() (*Project, error) {
+	var s struct {
+		Project *Project `json:"project"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Project, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Project.
+type GetResult struct {
+	projectResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Project.
+type CreateResult struct {
+	projectResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Project.
+type UpdateResult struct {
+	projectResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// TagsResult is the response from a ListTags or ReplaceTags operation. Call
+// its Extract method to interpret it as a list of tags.
+type TagsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a TagsResult as a list of tags.
+func (r TagsResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []string `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
+
+// TagResult is the response from an AddTag, DeleteTag or DeleteTags
+// operation. Call its ExtractErr method to determine if the request succeeded
+// or failed.
+type TagResult struct {
+	gophercloud.ErrResult
+}
+
+// ProjectPage is a single page of Project results.
+type ProjectPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ProjectPage contains any results.
+func (r ProjectPage) IsEmpty() (bool, error) {
+	projects, err := ExtractProjects(r)
+	return len(projects) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ProjectPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractProjects returns a slice of Projects contained in a single page of
+// results.
+func ExtractProjects(r pagination.Page) ([]Project, error) {
+	var s struct {
+		Projects []Project `json:"projects"`
+	}
+	err := (r.(ProjectPage)).ExtractInto(&s)
+	return s.Projects, err
+}
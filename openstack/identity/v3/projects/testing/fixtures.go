@@ -0,0 +1,333 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Project results.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null,
+		"self": "http://example.com/identity/v3/projects"
+	},
+	"projects": [
+		{
+			"is_domain": false,
+			"description": "The team that is red",
+			"domain_id": "default",
+			"enabled": true,
+			"id": "1234",
+			"name": "Red Team",
+			"parent_id": "default",
+			"tags": ["ci"],
+			"links": {
+				"self": "http://example.com/identity/v3/projects/1234"
+			}
+		},
+		{
+			"is_domain": false,
+			"description": "The team that is blue",
+			"domain_id": "default",
+			"enabled": false,
+			"id": "9876",
+			"name": "Blue Team",
+			"parent_id": "1234",
+			"tags": [],
+			"links": {
+				"self": "http://example.com/identity/v3/projects/9876"
+			}
+		}
+	]
+}
+`
+
+// GetOutput provides a Get result, including the project's subtree.
+const GetOutput = `
+{
+	"project": {
+		"is_domain": false,
+		"description": "The team that is red",
+		"domain_id": "default",
+		"enabled": true,
+		"id": "1234",
+		"name": "Red Team",
+		"parent_id": "default",
+		"tags": ["ci"],
+		"links": {
+			"self": "http://example.com/identity/v3/projects/1234"
+		},
+		"subtree": [
+			{
+				"project": {
+					"is_domain": false,
+					"description": "The team that is blue",
+					"domain_id": "default",
+					"enabled": false,
+					"id": "9876",
+					"name": "Blue Team",
+					"parent_id": "1234",
+					"tags": [],
+					"links": {
+						"self": "http://example.com/identity/v3/projects/9876"
+					}
+				}
+			}
+		]
+	}
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+	"project": {
+		"name": "Red Team",
+		"description": "The team that is red",
+		"domain_id": "default",
+		"tags": ["ci"]
+	}
+}
+`
+
+// CreateOutput provides a Create result.
+const CreateOutput = `
+{
+	"project": {
+		"is_domain": false,
+		"description": "The team that is red",
+		"domain_id": "default",
+		"enabled": true,
+		"id": "1234",
+		"name": "Red Team",
+		"parent_id": "default",
+		"tags": ["ci"],
+		"links": {
+			"self": "http://example.com/identity/v3/projects/1234"
+		}
+	}
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+	"project": {
+		"description": "",
+		"enabled": false,
+		"tags": []
+	}
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+	"project": {
+		"is_domain": false,
+		"description": "",
+		"domain_id": "default",
+		"enabled": false,
+		"id": "1234",
+		"name": "Red Team",
+		"parent_id": "default",
+		"tags": [],
+		"links": {
+			"self": "http://example.com/identity/v3/projects/1234"
+		}
+	}
+}
+`
+
+// RedTeam is a Project fixture.
+var RedTeam = projects.Project{
+	IsDomain:    false,
+	Description: "The team that is red",
+	DomainID:    "default",
+	Enabled:     true,
+	ID:          "1234",
+	Name:        "Red Team",
+	ParentID:    "default",
+	Tags:        []string{"ci"},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/projects/1234",
+	},
+}
+
+// BlueTeam is a Project fixture.
+var BlueTeam = projects.Project{
+	IsDomain:    false,
+	Description: "The team that is blue",
+	DomainID:    "default",
+	Enabled:     false,
+	ID:          "9876",
+	Name:        "Blue Team",
+	ParentID:    "1234",
+	Tags:        []string{},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/projects/9876",
+	},
+}
+
+// ExpectedProjectSlice is the slice of projects expected to be returned from
+// ListOutput.
+var ExpectedProjectSlice = []projects.Project{RedTeam, BlueTeam}
+
+// HandleListProjectsSuccessfully creates an HTTP handler at `/projects` on
+// the test handler mux that responds with a list of two projects.
+func HandleListProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"domain_id": "default",
+			"enabled":   "false",
+			"tags-any":  "ci,prod",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleListAvailableProjectsSuccessfully creates an HTTP handler at
+// `/auth/projects` on the test handler mux that responds with a list of two
+// projects.
+func HandleListAvailableProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetProjectSuccessfully creates an HTTP handler at `/projects/1234`
+// on the test handler mux that responds with a single project and its
+// subtree.
+func HandleGetProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"subtree_as_list": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateProjectSuccessfully creates an HTTP handler at `/projects` on
+// the test handler mux that tests project creation.
+func HandleCreateProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateOutput)
+	})
+}
+
+// HandleUpdateProjectSuccessfully creates an HTTP handler at `/projects/1234`
+// on the test handler mux that tests project updates.
+func HandleUpdateProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteProjectSuccessfully creates an HTTP handler at `/projects/1234`
+// on the test handler mux that tests project deletion.
+func HandleDeleteProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleProjectTagsSuccessfully creates HTTP handlers under
+// `/projects/1234/tags` on the test handler mux that test the tag
+// operations. The project is tagged "ci" and nothing else.
+func HandleProjectTagsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"tags": ["ci"], "links": {}}`)
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"tags": ["ci", "prod"]}`)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"tags": ["ci", "prod"], "links": {}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/projects/1234/tags/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		tag := r.URL.Path[len("/projects/1234/tags/"):]
+		switch r.Method {
+		case "HEAD":
+			if tag == "ci" {
+				w.WriteHeader(http.StatusNoContent)
+			} else {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case "PUT":
+			w.WriteHeader(http.StatusCreated)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleFindProjectSuccessfully creates an HTTP handler at `/projects` on the
+// test handler mux that responds with the projects matching the name and
+// domain filters.
+func HandleFindProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":      "Red Team",
+			"domain_id": "1789d1",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"projects": [{"id": "3d4c2c", "name": "Red Team", "domain_id": "1789d1"}], "links": {"next": null}}`)
+	})
+}
@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProjectsSuccessfully(t)
+
+	enabled := false
+	listOpts := projects.ListOpts{
+		DomainID: "default",
+		Enabled:  &enabled,
+		TagsAny:  "ci,prod",
+	}
+
+	count := 0
+	err := projects.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := projects.ExtractProjects(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedProjectSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListAvailableProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAvailableProjectsSuccessfully(t)
+
+	allPages, err := projects.ListAvailable(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectSlice, actual)
+}
+
+func TestGetProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetProjectSuccessfully(t)
+
+	expected := RedTeam
+	expected.Subtree = []projects.Project{BlueTeam}
+
+	actual, err := projects.Get(client.ServiceClient(), "1234", projects.GetOpts{SubtreeAsList: true}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestCreateProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateProjectSuccessfully(t)
+
+	createOpts := projects.CreateOpts{
+		Name:        "Red Team",
+		Description: "The team that is red",
+		DomainID:    "default",
+		Tags:        []string{"ci"},
+	}
+
+	actual, err := projects.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, RedTeam, *actual)
+}
+
+func TestUpdateProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateProjectSuccessfully(t)
+
+	description := ""
+	enabled := false
+	tags := []string{}
+	updateOpts := projects.UpdateOpts{
+		Description: &description,
+		Enabled:     &enabled,
+		Tags:        &tags,
+	}
+
+	expected := RedTeam
+	expected.Description = ""
+	expected.Enabled = false
+	expected.Tags = []string{}
+
+	actual, err := projects.Update(client.ServiceClient(), "1234", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteProjectSuccessfully(t)
+
+	res := projects.Delete(client.ServiceClient(), "1234")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestProjectTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProjectTagsSuccessfully(t)
+
+	c := client.ServiceClient()
+
+	tags, err := projects.ListTags(c, "1234").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"ci"}, tags)
+
+	tags, err = projects.ReplaceTags(c, "1234", []string{"ci", "prod"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"ci", "prod"}, tags)
+
+	ok, err := projects.CheckTag(c, "1234", "ci")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	ok, err = projects.CheckTag(c, "1234", "prod")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, ok)
+
+	th.AssertNoErr(t, projects.AddTag(c, "1234", "prod").ExtractErr())
+	th.AssertNoErr(t, projects.DeleteTag(c, "1234", "prod").ExtractErr())
+	th.AssertNoErr(t, projects.DeleteTags(c, "1234").ExtractErr())
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFindProjectSuccessfully(t)
+
+	id, err := projects.IDFromName(client.ServiceClient(), "Red Team", "1789d1")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "3d4c2c", id)
+}
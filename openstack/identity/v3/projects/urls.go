@@ -0,0 +1,23 @@
+package projects
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("projects")
+}
+
+func listAvailableURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("auth", "projects")
+}
+
+func projectURL(client *gophercloud.ServiceClient, projectID string) string {
+	return client.ServiceURL("projects", projectID)
+}
+
+func tagsURL(client *gophercloud.ServiceClient, projectID string) string {
+	return client.ServiceURL("projects", projectID, "tags")
+}
+
+func tagURL(client *gophercloud.ServiceClient, projectID, tag string) string {
+	return client.ServiceURL("projects", projectID, "tags", tag)
+}
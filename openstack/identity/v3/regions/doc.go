@@ -0,0 +1,38 @@
+/*
+Package regions provides information and interaction with the regions API
+resource for the OpenStack Identity service.
+
+Example to List the Subregions of a Region
+
+	allPages, err := regions.List(identityClient, regions.ListOpts{ParentRegionID: "RegionOne"}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRegions, err := regions.ExtractRegions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Region
+
+	createOpts := regions.CreateOpts{
+		ID:             "RegionOne-AZ2",
+		Description:    "Second availability zone of RegionOne",
+		ParentRegionID: "RegionOne",
+	}
+
+	region, err := regions.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Make a Region a Top-Level Region
+
+	noParent := ""
+	region, err := regions.Update(identityClient, "RegionOne-AZ2", regions.UpdateOpts{ParentRegionID: &noParent}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package regions
@@ -0,0 +1,139 @@
+package regions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToRegionListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// ParentRegionID filters the regions by their parent region.
+	ParentRegionID string `q:"parent_region_id"`
+}
+
+// ToRegionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRegionListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the regions.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToRegionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RegionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a region, given its ID.
+func Get(client *gophercloud.ServiceClient, regionID string) (r GetResult) {
+	_, r.Err = client.Get(regionURL(client, regionID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToRegionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new region.
+type CreateOpts struct {
+	// ID is the ID of the new region, e.g. "RegionOne". The Identity service
+	// generates one if it is omitted.
+	ID string `json:"id,omitempty"`
+
+	// Description is a description of the region.
+	Description string `json:"description,omitempty"`
+
+	// ParentRegionID is the ID of the region that contains the new region.
+	ParentRegionID string `json:"parent_region_id,omitempty"`
+}
+
+// ToRegionCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToRegionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "region")
+}
+
+// Create creates a new region.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRegionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToRegionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a region to update.
+type UpdateOpts struct {
+	// Description is the new description of the region. Set it to a pointer
+	// to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+
+	// ParentRegionID is the ID of the new parent region. Set it to a pointer
+	// to an empty string to make the region a top-level region.
+	ParentRegionID *string `json:"-"`
+}
+
+// ToRegionUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToRegionUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "region")
+	if err != nil {
+		return nil, err
+	}
+
+	// A top-level region has a null parent, not an empty one.
+	if opts.ParentRegionID != nil {
+		region := b["region"].(map[string]interface{})
+		if *opts.ParentRegionID == "" {
+			region["parent_region_id"] = nil
+		} else {
+			region["parent_region_id"] = *opts.ParentRegionID
+		}
+	}
+
+	return b, nil
+}
+
+// Update modifies the attributes of a region.
+func Update(client *gophercloud.ServiceClient, regionID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRegionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(regionURL(client, regionID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a region. The region must not have child regions or
+// endpoints.
+func Delete(client *gophercloud.ServiceClient, regionID string) (r DeleteResult) {
+	_, r.Err = client.Delete(regionURL(client, regionID), nil)
+	return
+}
@@ -0,0 +1,91 @@
+package regions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Region represents an OpenStack Identity region.
+type Region struct {
+	// ID is the ID of the region.
+	ID string `json:"id"`
+
+	// Description is the description of the region.
+	Description string `json:"description"`
+
+	// ParentRegionID is the ID of the region that contains the region. It is
+	// empty for top-level regions.
+	ParentRegionID string `json:"parent_region_id"`
+
+	// Links contains referencing links to the region.
+	Links map[string]interface{} `json:"links"`
+}
+
+type regionResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Region.
+func (r regionResult) Extract() (*Region, error) {
+	var s struct {
+		Region *Region `json:"region"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Region, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Region.
+type GetResult struct {
+	regionResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Region.
+type CreateResult struct {
+	regionResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Region.
+type UpdateResult struct {
+	regionResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// RegionPage is a single page of Region results.
+type RegionPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a RegionPage contains any results.
+func (r RegionPage) IsEmpty() (bool, error) {
+	regions, err := ExtractRegions(r)
+	return len(regions) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r RegionPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractRegions returns a slice of Regions contained in a single page of
+// results.
+func ExtractRegions(r pagination.Page) ([]Region, error) {
+	var s struct {
+		Regions []Region `json:"regions"`
+	}
+	err := (r.(RegionPage)).ExtractInto(&s)
+	return s.Regions, err
+}
@@ -0,0 +1,193 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/regions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Region results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/regions"
+    },
+    "regions": [
+        {
+            "id": "RegionOne-East",
+            "description": "East sub-region of RegionOne",
+            "links": {
+                "self": "http://example.com/identity/v3/regions/RegionOne-East"
+            },
+            "parent_region_id": "RegionOne"
+        },
+        {
+            "id": "RegionOne-West",
+            "description": "West sub-region of RegionOne",
+            "links": {
+                "self": "https://example.com/identity/v3/regions/RegionOne-West"
+            },
+            "parent_region_id": "RegionOne"
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "region": {
+        "id": "RegionOne-West",
+        "description": "West sub-region of RegionOne",
+        "links": {
+            "self": "https://example.com/identity/v3/regions/RegionOne-West"
+        },
+        "parent_region_id": "RegionOne"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "region": {
+        "id": "RegionOne-West",
+        "description": "West sub-region of RegionOne",
+        "parent_region_id": "RegionOne"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "region": {
+        "description": "First West sub-region",
+        "parent_region_id": null
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "region": {
+        "id": "RegionOne-West",
+        "description": "First West sub-region",
+        "links": {
+            "self": "https://example.com/identity/v3/regions/RegionOne-West"
+        },
+        "parent_region_id": null
+    }
+}
+`
+
+// FirstRegion is the first region in the List request.
+var FirstRegion = regions.Region{
+	ID:          "RegionOne-East",
+	Description: "East sub-region of RegionOne",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/regions/RegionOne-East",
+	},
+	ParentRegionID: "RegionOne",
+}
+
+// SecondRegion is the second region in the List request.
+var SecondRegion = regions.Region{
+	ID:          "RegionOne-West",
+	Description: "West sub-region of RegionOne",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/regions/RegionOne-West",
+	},
+	ParentRegionID: "RegionOne",
+}
+
+// SecondRegionUpdated is how SecondRegion should look after an Update.
+var SecondRegionUpdated = regions.Region{
+	ID:          "RegionOne-West",
+	Description: "First West sub-region",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/regions/RegionOne-West",
+	},
+}
+
+// ExpectedRegionsSlice is the slice of regions expected to be returned from
+// ListOutput.
+var ExpectedRegionsSlice = []regions.Region{FirstRegion, SecondRegion}
+
+// HandleListRegionsSuccessfully creates an HTTP handler at `/regions` on the
+// test handler mux that responds with a list of two regions.
+func HandleListRegionsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/regions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"parent_region_id": "RegionOne"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetRegionSuccessfully creates an HTTP handler at
+// `/regions/RegionOne-West` on the test handler mux that responds with a
+// single region.
+func HandleGetRegionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/regions/RegionOne-West", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateRegionSuccessfully creates an HTTP handler at `/regions` on
+// the test handler mux that tests region creation.
+func HandleCreateRegionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/regions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateRegionSuccessfully creates an HTTP handler at
+// `/regions/RegionOne-West` on the test handler mux that tests region
+// updates.
+func HandleUpdateRegionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/regions/RegionOne-West", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteRegionSuccessfully creates an HTTP handler at
+// `/regions/RegionOne-West` on the test handler mux that tests region
+// deletion.
+func HandleDeleteRegionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/regions/RegionOne-West", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
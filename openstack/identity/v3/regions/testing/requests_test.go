@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/regions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListRegions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListRegionsSuccessfully(t)
+
+	count := 0
+	err := regions.List(client.ServiceClient(), regions.ListOpts{ParentRegionID: "RegionOne"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := regions.ExtractRegions(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedRegionsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetRegion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetRegionSuccessfully(t)
+
+	actual, err := regions.Get(client.ServiceClient(), "RegionOne-West").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondRegion, *actual)
+}
+
+func TestCreateRegion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateRegionSuccessfully(t)
+
+	createOpts := regions.CreateOpts{
+		ID:             "RegionOne-West",
+		Description:    "West sub-region of RegionOne",
+		ParentRegionID: "RegionOne",
+	}
+
+	actual, err := regions.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondRegion, *actual)
+}
+
+func TestUpdateRegion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRegionSuccessfully(t)
+
+	description := "First West sub-region"
+	noParent := ""
+	updateOpts := regions.UpdateOpts{
+		Description:    &description,
+		ParentRegionID: &noParent,
+	}
+
+	actual, err := regions.Update(client.ServiceClient(), "RegionOne-West", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondRegionUpdated, *actual)
+}
+
+func TestUpdateRegionParent(t *testing.T) {
+	parent := "RegionTwo"
+	b, err := regions.UpdateOpts{ParentRegionID: &parent}.ToRegionUpdateMap()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]interface{}{
+		"region": map[string]interface{}{"parent_region_id": "RegionTwo"},
+	}, b)
+}
+
+func TestDeleteRegion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteRegionSuccessfully(t)
+
+	res := regions.Delete(client.ServiceClient(), "RegionOne-West")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,11 @@
+package regions
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("regions")
+}
+
+func regionURL(client *gophercloud.ServiceClient, regionID string) string {
+	return client.ServiceURL("regions", regionID)
+}
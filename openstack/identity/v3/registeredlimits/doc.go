@@ -0,0 +1,69 @@
+/*
+Package registeredlimits provides information and interaction with the
+registered limits API resource for the OpenStack Identity service.
+
+Registered limits are the default limits of the resources of the services
+taking part in unified limits. They apply to every project that has no limit
+of its own; see the limits package.
+
+Example to List Registered Limits
+
+	listOpts := registeredlimits.ListOpts{
+		ServiceID: "9408080f1970482aa0e38bc2d4ea34b7",
+	}
+
+	allPages, err := registeredlimits.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRegisteredLimits, err := registeredlimits.ExtractRegisteredLimits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, registeredLimit := range allRegisteredLimits {
+		fmt.Printf("%+v\n", registeredLimit)
+	}
+
+Example to Create Registered Limits
+
+	createOpts := registeredlimits.BatchCreateOpts{
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "cores",
+			DefaultLimit: 20,
+		},
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			RegionID:     "RegionOne",
+			ResourceName: "ram_mb",
+			DefaultLimit: 51200,
+		},
+	}
+
+	registeredLimits, err := registeredlimits.BatchCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Registered Limit
+
+	defaultLimit := 40
+	updateOpts := registeredlimits.UpdateOpts{
+		DefaultLimit: &defaultLimit,
+	}
+
+	registeredLimit, err := registeredlimits.Update(identityClient, registeredLimitID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Registered Limit
+
+	err := registeredlimits.Delete(identityClient, registeredLimitID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package registeredlimits
@@ -0,0 +1,165 @@
+package registeredlimits
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToRegisteredLimitListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// ServiceID filters the registered limits by service.
+	ServiceID string `q:"service_id"`
+
+	// RegionID filters the registered limits by region.
+	RegionID string `q:"region_id"`
+
+	// ResourceName filters the registered limits by the name of the resource
+	// they limit, e.g. "cores".
+	ResourceName string `q:"resource_name"`
+}
+
+// ToRegisteredLimitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRegisteredLimitListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the registered limits.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToRegisteredLimitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RegisteredLimitPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single registered limit, by ID.
+func Get(client *gophercloud.ServiceClient, registeredLimitID string) (r GetResult) {
+	_, r.Err = client.Get(registeredLimitURL(client, registeredLimitID), &r.Body, nil)
+	return
+}
+
+// CreateOpts specifies the attributes of a new registered limit.
+type CreateOpts struct {
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id" required:"true"`
+
+	// RegionID is the ID of the region the registered limit applies to. If
+	// empty, it applies to all of the regions.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ResourceName is the name of the resource to limit, e.g. "cores".
+	ResourceName string `json:"resource_name" required:"true"`
+
+	// DefaultLimit is the limit of projects that have no limit of their own.
+	DefaultLimit int `json:"default_limit"`
+
+	// Description is a description of the registered limit.
+	Description string `json:"description,omitempty"`
+}
+
+// ToMap formats a CreateOpts into a registered limit of a create request.
+func (opts CreateOpts) ToMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// BatchCreateOptsBuilder allows extensions to add additional parameters to
+// the BatchCreate request.
+type BatchCreateOptsBuilder interface {
+	ToRegisteredLimitsCreateMap() (map[string]interface{}, error)
+}
+
+// BatchCreateOpts lists the registered limits to create.
+type BatchCreateOpts []CreateOpts
+
+// ToRegisteredLimitsCreateMap formats a BatchCreateOpts into a create
+// request.
+func (opts BatchCreateOpts) ToRegisteredLimitsCreateMap() (map[string]interface{}, error) {
+	registeredLimits := make([]map[string]interface{}, len(opts))
+	for i, registeredLimit := range opts {
+		b, err := registeredLimit.ToMap()
+		if err != nil {
+			return nil, err
+		}
+		registeredLimits[i] = b
+	}
+	return map[string]interface{}{"registered_limits": registeredLimits}, nil
+}
+
+// BatchCreate creates one or more registered limits. Either all of them are
+// created, or none is.
+func BatchCreate(client *gophercloud.ServiceClient, opts BatchCreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRegisteredLimitsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToRegisteredLimitUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a registered limit to update. The
+// service, region and resource name of a registered limit cannot be changed
+// once project limits refer to it.
+type UpdateOpts struct {
+	// ServiceID is the ID of the new service of the registered limit.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// RegionID is the ID of the new region of the registered limit.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ResourceName is the new name of the limited resource.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// DefaultLimit is the new default limit.
+	DefaultLimit *int `json:"default_limit,omitempty"`
+
+	// Description is the new description of the registered limit. Set it to
+	// a pointer to an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToRegisteredLimitUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToRegisteredLimitUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "registered_limit")
+}
+
+// Update modifies the attributes of a registered limit.
+func Update(client *gophercloud.ServiceClient, registeredLimitID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRegisteredLimitUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(registeredLimitURL(client, registeredLimitID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a registered limit. Project limits of the same resource
+// must be deleted first.
+func Delete(client *gophercloud.ServiceClient, registeredLimitID string) (r DeleteResult) {
+	_, r.Err = client.Delete(registeredLimitURL(client, registeredLimitID), nil)
+	return
+}
@@ -0,0 +1,101 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/registeredlimits"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListRegisteredLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListRegisteredLimitsSuccessfully(t)
+
+	count := 0
+	listOpts := registeredlimits.ListOpts{ServiceID: "9408080f1970482aa0e38bc2d4ea34b7"}
+	err := registeredlimits.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := registeredlimits.ExtractRegisteredLimits(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedRegisteredLimitsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetRegisteredLimitSuccessfully(t)
+
+	actual, err := registeredlimits.Get(client.ServiceClient(), FirstRegisteredLimit.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstRegisteredLimit, *actual)
+}
+
+func TestBatchCreateRegisteredLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateRegisteredLimitsSuccessfully(t)
+
+	createOpts := registeredlimits.BatchCreateOpts{
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "cores",
+			DefaultLimit: 20,
+			Description:  "Cores of a project",
+		},
+		{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			RegionID:     "RegionOne",
+			ResourceName: "ram_mb",
+			DefaultLimit: 51200,
+		},
+	}
+
+	actual, err := registeredlimits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRegisteredLimitsSlice, actual)
+}
+
+func TestBatchCreateRegisteredLimitsMissingResourceName(t *testing.T) {
+	createOpts := registeredlimits.BatchCreateOpts{
+		{ServiceID: "9408080f1970482aa0e38bc2d4ea34b7", DefaultLimit: 20},
+	}
+
+	_, err := createOpts.ToRegisteredLimitsCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for a registered limit without a resource name")
+	}
+}
+
+func TestUpdateRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRegisteredLimitSuccessfully(t)
+
+	defaultLimit := 40
+	updateOpts := registeredlimits.UpdateOpts{
+		DefaultLimit: &defaultLimit,
+	}
+
+	actual, err := registeredlimits.Update(client.ServiceClient(), FirstRegisteredLimit.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 40, actual.DefaultLimit)
+}
+
+func TestDeleteRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteRegisteredLimitSuccessfully(t)
+
+	res := registeredlimits.Delete(client.ServiceClient(), FirstRegisteredLimit.ID)
+	th.AssertNoErr(t, res.Err)
+}
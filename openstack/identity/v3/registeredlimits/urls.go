@@ -0,0 +1,11 @@
+package registeredlimits
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("registered_limits")
+}
+
+func registeredLimitURL(client *gophercloud.ServiceClient, registeredLimitID string) string {
+	return client.ServiceURL("registered_limits", registeredLimitID)
+}
@@ -1,3 +1,66 @@
-// Package roles provides information and interaction with the roles API
-// resource for the OpenStack Identity service.
+/*
+Package roles provides information and interaction with the roles API
+resource for the OpenStack Identity service.
+
+Example to List Role Assignments
+
+	listOpts := roles.ListAssignmentsOpts{
+		UserID:         "97061de2ed0647b28a393c36ab584f39",
+		ScopeProjectID: "9df1a02f5eb2416a9781e8b0c022d3ae",
+	}
+
+	allPages, err := roles.ListAssignments(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoleAssignments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Domain-Specific Role
+
+	createOpts := roles.CreateOpts{
+		Name:     "operator",
+		DomainID: "default",
+	}
+
+	role, err := roles.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Grant a Role to a Group on All Projects of a Domain
+
+	assignOpts := roles.AssignOpts{
+		GroupID:             "9fe1d3",
+		DomainID:            "default",
+		InheritedToProjects: true,
+	}
+
+	err := roles.Assign(identityClient, roleID, assignOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Grant a Role to a User on the System
+
+	assignOpts := roles.AssignOpts{
+		UserID: "97061de2ed0647b28a393c36ab584f39",
+		System: true,
+	}
+
+	err := roles.Assign(identityClient, roleID, assignOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Make a Role Imply Another
+
+	rule, err := roles.CreateImpliedRole(identityClient, adminRoleID, memberRoleID).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
 package roles
@@ -2,6 +2,7 @@ package roles
 
 import (
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -13,7 +14,8 @@ type ListAssignmentsOptsBuilder interface {
 
 // ListAssignmentsOpts allows you to query the ListAssignments method.
 // Specify one of or a combination of GroupId, RoleId, ScopeDomainId, ScopeProjectId,
-// and/or UserId to search for roles assigned to corresponding entities.
+// ScopeSystem and/or UserId to search for roles assigned to corresponding entities.
+// ScopeSystem is "all" for assignments on the system.
 // Effective lists effective assignments at the user, project, and domain level,
 // allowing for the effects of group membership.
 type ListAssignmentsOpts struct {
@@ -21,6 +23,7 @@ type ListAssignmentsOpts struct {
 	RoleID         string `q:"role.id"`
 	ScopeDomainID  string `q:"scope.domain.id"`
 	ScopeProjectID string `q:"scope.project.id"`
+	ScopeSystem    string `q:"scope.system"`
 	UserID         string `q:"user.id"`
 	Effective      *bool  `q:"effective"`
 }
@@ -28,7 +31,21 @@ type ListAssignmentsOpts struct {
 // ToRolesListAssignmentsQuery formats a ListAssignmentsOpts into a query string.
 func (opts ListAssignmentsOpts) ToRolesListAssignmentsQuery() (string, error) {
 	q, err := gophercloud.BuildQueryString(opts)
-	return q.String(), err
+	if err != nil {
+		return "", err
+	}
+
+	// effective is a flag: the Identity service only checks for its presence.
+	query := q.String()
+	if opts.Effective != nil && *opts.Effective {
+		if query == "" {
+			query = "?effective"
+		} else {
+			query += "&effective"
+		}
+	}
+
+	return query, nil
 }
 
 // ListAssignments enumerates the roles assigned to a specified resource.
@@ -45,3 +62,322 @@ func ListAssignments(client *gophercloud.ServiceClient, opts ListAssignmentsOpts
 		return RoleAssignmentPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToRoleListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// DomainID filters the response by the domain of domain-specific roles.
+	DomainID string `q:"domain_id"`
+
+	// Name filters the response by role name.
+	Name string `q:"name"`
+}
+
+// ToRoleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRoleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the roles. Without a DomainID filter only global roles are
+// listed.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToRoleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single role, by ID.
+func Get(client *gophercloud.ServiceClient, roleID string) (r GetResult) {
+	_, r.Err = client.Get(roleURL(client, roleID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToRoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a role.
+type CreateOpts struct {
+	// Name is the name of the new role.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain of a domain-specific role. Leave it
+	// empty to create a global role.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is a description of the role.
+	Description string `json:"description,omitempty"`
+
+	// Options are the resource options of the role, e.g. "immutable".
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// ToRoleCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToRoleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "role")
+}
+
+// Create creates a new Role.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToRoleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a role.
+type UpdateOpts struct {
+	// Name is the new name of the role.
+	Name string `json:"name,omitempty"`
+
+	// Description is the new description of the role. Set it to a pointer to
+	// an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+
+	// Options are the resource options of the role to change.
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// ToRoleUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToRoleUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "role")
+}
+
+// Update updates an existing Role.
+func Update(client *gophercloud.ServiceClient, roleID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRoleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(roleURL(client, roleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a role.
+func Delete(client *gophercloud.ServiceClient, roleID string) (r DeleteResult) {
+	_, r.Err = client.Delete(roleURL(client, roleID), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a role's ID given its
+// name. The names of domain-specific roles are only unique within their
+// domain, which is given by domainID. If domainID is empty, only global roles
+// are considered.
+func IDFromName(client *gophercloud.ServiceClient, name, domainID string) (string, error) {
+	return utils.IDFromName(name, utils.FindOpts{
+		ResourceType: "role",
+		Key:          "roles",
+		List: func(name string) pagination.Pager {
+			return List(client, ListOpts{Name: name, DomainID: domainID})
+		},
+	})
+}
+
+// AssignOpts identifies the actor and the target of a role grant. Exactly one
+// of UserID and GroupID must be set, and exactly one of ProjectID, DomainID
+// and System.
+type AssignOpts struct {
+	// UserID is the ID of the user the role is granted to.
+	UserID string
+
+	// GroupID is the ID of the group the role is granted to.
+	GroupID string
+
+	// ProjectID is the ID of the project the role is granted on.
+	ProjectID string
+
+	// DomainID is the ID of the domain the role is granted on.
+	DomainID string
+
+	// System grants the role on the system, for operations that are not
+	// bound to a project or domain.
+	System bool
+
+	// InheritedToProjects makes the grant apply to the projects in the domain,
+	// or to the subtree of the project, instead of the target itself. It
+	// cannot be combined with System.
+	InheritedToProjects bool
+}
+
+// target returns the URL components of the grant described by opts.
+func (opts AssignOpts) target() (targetType, targetID, actorType, actorID string, err error) {
+	switch {
+	case opts.UserID != "" && opts.GroupID != "":
+		return "", "", "", "", invalidAssignOpts("UserID/GroupID", "Only one of UserID and GroupID may be provided")
+	case opts.UserID != "":
+		actorType, actorID = "users", opts.UserID
+	case opts.GroupID != "":
+		actorType, actorID = "groups", opts.GroupID
+	default:
+		return "", "", "", "", gophercloud.ErrMissingInput{Argument: "UserID/GroupID"}
+	}
+
+	targets := 0
+	if opts.ProjectID != "" {
+		targets++
+		targetType, targetID = "projects", opts.ProjectID
+	}
+	if opts.DomainID != "" {
+		targets++
+		targetType, targetID = "domains", opts.DomainID
+	}
+	if opts.System {
+		targets++
+		targetType, targetID = "system", ""
+	}
+	switch {
+	case targets == 0:
+		return "", "", "", "", gophercloud.ErrMissingInput{Argument: "ProjectID/DomainID/System"}
+	case targets > 1:
+		return "", "", "", "", invalidAssignOpts("ProjectID/DomainID/System", "Only one of ProjectID, DomainID and System may be provided")
+	case opts.System && opts.InheritedToProjects:
+		return "", "", "", "", invalidAssignOpts("InheritedToProjects", "System grants cannot be inherited to projects")
+	}
+
+	return targetType, targetID, actorType, actorID, nil
+}
+
+func invalidAssignOpts(argument, info string) error {
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = argument
+	err.Info = info
+	return err
+}
+
+// Assign grants a role to a user or group on a project, domain or the system.
+func Assign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
+	targetType, targetID, actorType, actorID, err := opts.target()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url := assignmentURL(client, targetType, targetID, actorType, actorID, roleID, opts.InheritedToProjects)
+	_, r.Err = client.Put(url, nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Unassign revokes a role grant described by opts.
+func Unassign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
+	targetType, targetID, actorType, actorID, err := opts.target()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url := assignmentURL(client, targetType, targetID, actorType, actorID, roleID, opts.InheritedToProjects)
+	_, r.Err = client.Delete(url, nil)
+	return
+}
+
+// CheckAssignment reports whether the role grant described by opts exists.
+// Only direct grants are considered: roles a user holds through group
+// membership or inheritance are listed by ListAssignments with Effective set.
+func CheckAssignment(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (bool, error) {
+	targetType, targetID, actorType, actorID, err := opts.target()
+	if err != nil {
+		return false, err
+	}
+	url := assignmentURL(client, targetType, targetID, actorType, actorID, roleID, opts.InheritedToProjects)
+	resp, err := client.Head(url, &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// ListAssignmentsOnResource lists the roles granted directly to the user or
+// group of opts on its project, domain or the system. If
+// opts.InheritedToProjects is set, the grants inherited to projects are
+// listed instead.
+func ListAssignmentsOnResource(client *gophercloud.ServiceClient, opts AssignOpts) pagination.Pager {
+	targetType, targetID, actorType, actorID, err := opts.target()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url := assignmentBaseURL(client, targetType, targetID, actorType, actorID, opts.InheritedToProjects)
+	if opts.InheritedToProjects {
+		url += "/" + inheritedToSuffix
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListImpliedRoles lists the roles implied by a prior role.
+func ListImpliedRoles(client *gophercloud.ServiceClient, priorRoleID string) (r ListImpliedRolesResult) {
+	_, r.Err = client.Get(listImpliedRolesURL(client, priorRoleID), &r.Body, nil)
+	return
+}
+
+// CreateImpliedRole creates a rule that whoever holds the prior role also
+// holds the implied role.
+func CreateImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r ImpliedRoleResult) {
+	_, r.Err = client.Put(impliedRoleURL(client, priorRoleID, impliedRoleID), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// GetImpliedRole retrieves an implied role rule.
+func GetImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r ImpliedRoleResult) {
+	_, r.Err = client.Get(impliedRoleURL(client, priorRoleID, impliedRoleID), &r.Body, nil)
+	return
+}
+
+// CheckImpliedRole reports whether the prior role implies the implied role.
+func CheckImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (bool, error) {
+	resp, err := client.Head(impliedRoleURL(client, priorRoleID, impliedRoleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// DeleteImpliedRole deletes an implied role rule.
+func DeleteImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r DeleteResult) {
+	_, r.Err = client.Delete(impliedRoleURL(client, priorRoleID, impliedRoleID), nil)
+	return
+}
+
+// ListRoleInferences lists every implied role rule of the deployment.
+func ListRoleInferences(client *gophercloud.ServiceClient) (r ListRoleInferencesResult) {
+	_, r.Err = client.Get(listRoleInferencesURL(client), &r.Body, nil)
+	return
+}
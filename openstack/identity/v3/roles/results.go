@@ -1,6 +1,9 @@
 package roles
 
-import "github.com/gophercloud/gophercloud/pagination"
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
 
 // RoleAssignment is the result of a role assignments query.
 type RoleAssignment struct {
@@ -10,13 +13,41 @@ type RoleAssignment struct {
 	Group Group `json:"group,omitempty"`
 }
 
+// Role is an Identity role. Role assignments and implied role rules only
+// carry a subset of its fields.
 type Role struct {
+	// ID is the unique ID of the role.
 	ID string `json:"id,omitempty"`
+
+	// Name is the name of the role.
+	Name string `json:"name,omitempty"`
+
+	// DomainID is the ID of the domain of a domain-specific role. It is empty
+	// for global roles.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is the description of the role.
+	Description string `json:"description,omitempty"`
+
+	// Links contains referencing links to the role.
+	Links map[string]interface{} `json:"links,omitempty"`
+
+	// Options are the resource options of the role.
+	Options map[string]interface{} `json:"options,omitempty"`
 }
 
+// Scope is the target of a role assignment. InheritedTo is "projects" for
+// grants inherited to projects.
 type Scope struct {
-	Domain  Domain  `json:"domain,omitempty"`
-	Project Project `json:"project,omitempty"`
+	Domain      Domain  `json:"domain,omitempty"`
+	Project     Project `json:"project,omitempty"`
+	System      System  `json:"system,omitempty"`
+	InheritedTo string  `json:"OS-INHERIT:inherited_to,omitempty"`
+}
+
+// System is the system target of a role assignment.
+type System struct {
+	All bool `json:"all,omitempty"`
 }
 
 type Domain struct {
@@ -65,3 +96,138 @@ func ExtractRoleAssignments(r pagination.Page) ([]RoleAssignment, error) {
 	err := (r.(RoleAssignmentPage)).ExtractInto(&s)
 	return s.RoleAssignments, err
 }
+
+type roleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a Role.
+func (r roleResult) Extract() (*Role, error) {
+	var s struct {
+		Role *Role `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Role.
+type GetResult struct {
+	roleResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Role.
+type CreateResult struct {
+	roleResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Role.
+type UpdateResult struct {
+	roleResult
+}
+
+// DeleteResult is the response from a Delete or DeleteImpliedRole operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssignmentResult is the response from an Assign or Unassign operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type AssignmentResult struct {
+	gophercloud.ErrResult
+}
+
+// RolePage is a single page of Role results.
+type RolePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a RolePage contains any results.
+func (r RolePage) IsEmpty() (bool, error) {
+	roles, err := ExtractRoles(r)
+	return len(roles) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r RolePage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractRoles returns a slice of Roles contained in a single page of
+// results.
+func ExtractRoles(r pagination.Page) ([]Role, error) {
+	var s struct {
+		Roles []Role `json:"roles"`
+	}
+	err := (r.(RolePage)).ExtractInto(&s)
+	return s.Roles, err
+}
+
+// RoleInference lists the roles implied by a prior role.
+type RoleInference struct {
+	PriorRole Role   `json:"prior_role"`
+	Implies   []Role `json:"implies"`
+}
+
+// ImpliedRole is a single implied role rule.
+type ImpliedRole struct {
+	PriorRole Role `json:"prior_role"`
+	Implies   Role `json:"implies"`
+}
+
+// ListImpliedRolesResult is the response from a ListImpliedRoles operation.
+// Call its Extract method to interpret it as a RoleInference.
+type ListImpliedRolesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListImpliedRolesResult as a RoleInference.
+func (r ListImpliedRolesResult) Extract() (*RoleInference, error) {
+	var s struct {
+		RoleInference *RoleInference `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// ImpliedRoleResult is the response from a CreateImpliedRole or
+// GetImpliedRole operation. Call its Extract method to interpret it as an
+// ImpliedRole.
+type ImpliedRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImpliedRoleResult as an ImpliedRole.
+func (r ImpliedRoleResult) Extract() (*ImpliedRole, error) {
+	var s struct {
+		ImpliedRole *ImpliedRole `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ImpliedRole, err
+}
+
+// ListRoleInferencesResult is the response from a ListRoleInferences
+// operation. Call its Extract method to interpret it as a slice of
+// RoleInferences.
+type ListRoleInferencesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListRoleInferencesResult as a slice of
+// RoleInferences, one per prior role.
+func (r ListRoleInferencesResult) Extract() ([]RoleInference, error) {
+	var s struct {
+		RoleInferences []RoleInference `json:"role_inferences"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInferences, err
+}
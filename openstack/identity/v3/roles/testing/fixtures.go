@@ -0,0 +1,403 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Role results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/roles"
+    },
+    "roles": [
+        {
+            "domain_id": "1789d1",
+            "id": "2844b2",
+            "name": "admin-read-only",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/2844b2"
+            }
+        },
+        {
+            "domain_id": "1789d1",
+            "id": "9fe1d3",
+            "name": "support",
+            "description": "Support staff",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            },
+            "options": {
+                "immutable": true
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "role": {
+        "domain_id": "1789d1",
+        "id": "9fe1d3",
+        "name": "support",
+        "description": "Support staff",
+        "links": {
+            "self": "https://example.com/identity/v3/roles/9fe1d3"
+        },
+        "options": {
+            "immutable": true
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "role": {
+        "domain_id": "1789d1",
+        "name": "support",
+        "description": "Support staff",
+        "options": {
+            "immutable": true
+        }
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "role": {
+        "description": "Level 1 support staff"
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "role": {
+        "domain_id": "1789d1",
+        "id": "9fe1d3",
+        "name": "support",
+        "description": "Level 1 support staff",
+        "links": {
+            "self": "https://example.com/identity/v3/roles/9fe1d3"
+        },
+        "options": {
+            "immutable": true
+        }
+    }
+}
+`
+
+// ListImpliedRolesOutput provides the roles implied by a prior role.
+const ListImpliedRolesOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "2844b2",
+            "name": "admin-read-only",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/2844b2"
+            }
+        },
+        "implies": [
+            {
+                "id": "9fe1d3",
+                "name": "support",
+                "links": {
+                    "self": "https://example.com/identity/v3/roles/9fe1d3"
+                }
+            }
+        ]
+    }
+}
+`
+
+// ImpliedRoleOutput provides a CreateImpliedRole or GetImpliedRole result.
+const ImpliedRoleOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "2844b2",
+            "name": "admin-read-only",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/2844b2"
+            }
+        },
+        "implies": {
+            "id": "9fe1d3",
+            "name": "support",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            }
+        }
+    }
+}
+`
+
+// ListRoleInferencesOutput provides every implied role rule.
+const ListRoleInferencesOutput = `
+{
+    "role_inferences": [
+        {
+            "prior_role": {
+                "id": "2844b2",
+                "name": "admin-read-only",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/2844b2"
+                }
+            },
+            "implies": [
+                {
+                    "id": "9fe1d3",
+                    "name": "support",
+                    "links": {
+                        "self": "https://example.com/identity/v3/roles/9fe1d3"
+                    }
+                }
+            ]
+        }
+    ]
+}
+`
+
+// FirstRole is the first role in the List request.
+var FirstRole = roles.Role{
+	DomainID: "1789d1",
+	ID:       "2844b2",
+	Name:     "admin-read-only",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/2844b2",
+	},
+}
+
+// SecondRole is the second role in the List request.
+var SecondRole = roles.Role{
+	DomainID:    "1789d1",
+	ID:          "9fe1d3",
+	Name:        "support",
+	Description: "Support staff",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/roles/9fe1d3",
+	},
+	Options: map[string]interface{}{
+		"immutable": true,
+	},
+}
+
+// SecondRoleUpdated is how SecondRole should look after an Update.
+var SecondRoleUpdated = roles.Role{
+	DomainID:    "1789d1",
+	ID:          "9fe1d3",
+	Name:        "support",
+	Description: "Level 1 support staff",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/roles/9fe1d3",
+	},
+	Options: map[string]interface{}{
+		"immutable": true,
+	},
+}
+
+// ExpectedRolesSlice is the slice of roles expected to be returned from
+// ListOutput.
+var ExpectedRolesSlice = []roles.Role{FirstRole, SecondRole}
+
+// PriorRoleRef is the prior role as referenced by implied role rules.
+var PriorRoleRef = roles.Role{
+	ID:   "2844b2",
+	Name: "admin-read-only",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/2844b2",
+	},
+}
+
+// ImpliedRoleRef is the implied role as referenced by implied role rules.
+var ImpliedRoleRef = roles.Role{
+	ID:   "9fe1d3",
+	Name: "support",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/roles/9fe1d3",
+	},
+}
+
+// ExpectedRoleInference is the RoleInference of ListImpliedRolesOutput.
+var ExpectedRoleInference = roles.RoleInference{
+	PriorRole: PriorRoleRef,
+	Implies:   []roles.Role{ImpliedRoleRef},
+}
+
+// ExpectedImpliedRole is the ImpliedRole of ImpliedRoleOutput.
+var ExpectedImpliedRole = roles.ImpliedRole{
+	PriorRole: PriorRoleRef,
+	Implies:   ImpliedRoleRef,
+}
+
+// HandleListRolesSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that responds with a list of two roles.
+func HandleListRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"domain_id": "1789d1"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetRoleSuccessfully creates an HTTP handler at `/roles/9fe1d3` on
+// the test handler mux that responds with a single role.
+func HandleGetRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateRoleSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that tests role creation.
+func HandleCreateRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateRoleSuccessfully creates an HTTP handler at `/roles/9fe1d3` on
+// the test handler mux that tests role updates.
+func HandleUpdateRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteRoleSuccessfully creates an HTTP handler at `/roles/9fe1d3` on
+// the test handler mux that tests role deletion.
+func HandleDeleteRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleAssignmentSuccessfully creates an HTTP handler at path on the test
+// handler mux that grants, checks and revokes a role.
+func HandleAssignmentSuccessfully(t *testing.T, path string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT", "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListAssignmentsOnResourceSuccessfully creates an HTTP handler at
+// path on the test handler mux that responds with a list of two roles.
+func HandleListAssignmentsOnResourceSuccessfully(t *testing.T, path string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleImpliedRoleSuccessfully creates HTTP handlers at
+// `/roles/2844b2/implies` and `/roles/2844b2/implies/9fe1d3` on the test
+// handler mux that manage the rule that the first role implies the second.
+func HandleImpliedRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/2844b2/implies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListImpliedRolesOutput)
+	})
+
+	th.Mux.HandleFunc("/roles/2844b2/implies/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ImpliedRoleOutput)
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ImpliedRoleOutput)
+		case "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/role_inferences", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListRoleInferencesOutput)
+	})
+}
+
+// HandleFindRoleSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that responds with the roles matching the name and
+// domain filters.
+func HandleFindRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":      "admin",
+			"domain_id": "1789d1",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"roles": [{"id": "9fe1d3", "name": "admin", "domain_id": "1789d1"}], "links": {"next": null}}`)
+	})
+}
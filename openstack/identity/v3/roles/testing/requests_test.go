@@ -103,3 +103,187 @@ func TestListSinglePage(t *testing.T) {
 		t.Errorf("Expected 1 page, got %d", count)
 	}
 }
+
+func TestListAssignmentsEffective(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.CheckEquals(t, "user.id=313233&effective", r.URL.RawQuery)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"role_assignments": [], "links": {"next": null}}`)
+	})
+
+	effective := true
+	opts := roles.ListAssignmentsOpts{UserID: "313233", Effective: &effective}
+	_, err := roles.ListAssignments(client.ServiceClient(), opts).AllPages()
+	testhelper.AssertNoErr(t, err)
+}
+
+func TestListRoles(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListRolesSuccessfully(t)
+
+	allPages, err := roles.List(client.ServiceClient(), roles.ListOpts{DomainID: "1789d1"}).AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := roles.ExtractRoles(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedRolesSlice, actual)
+}
+
+func TestGetRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleGetRoleSuccessfully(t)
+
+	actual, err := roles.Get(client.ServiceClient(), "9fe1d3").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRole, *actual)
+}
+
+func TestCreateRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleCreateRoleSuccessfully(t)
+
+	createOpts := roles.CreateOpts{
+		Name:        "support",
+		DomainID:    "1789d1",
+		Description: "Support staff",
+		Options: map[string]interface{}{
+			"immutable": true,
+		},
+	}
+
+	actual, err := roles.Create(client.ServiceClient(), createOpts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRole, *actual)
+}
+
+func TestUpdateRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleUpdateRoleSuccessfully(t)
+
+	description := "Level 1 support staff"
+	actual, err := roles.Update(client.ServiceClient(), "9fe1d3", roles.UpdateOpts{Description: &description}).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRoleUpdated, *actual)
+}
+
+func TestDeleteRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleDeleteRoleSuccessfully(t)
+
+	res := roles.Delete(client.ServiceClient(), "9fe1d3")
+	testhelper.AssertNoErr(t, res.Err)
+}
+
+func TestAssignments(t *testing.T) {
+	cases := []struct {
+		path string
+		opts roles.AssignOpts
+	}{
+		{"/projects/9df1a0/users/970610/roles/9fe1d3", roles.AssignOpts{UserID: "970610", ProjectID: "9df1a0"}},
+		{"/projects/9df1a0/groups/ea167b/roles/9fe1d3", roles.AssignOpts{GroupID: "ea167b", ProjectID: "9df1a0"}},
+		{"/domains/1789d1/users/970610/roles/9fe1d3", roles.AssignOpts{UserID: "970610", DomainID: "1789d1"}},
+		{"/domains/1789d1/groups/ea167b/roles/9fe1d3", roles.AssignOpts{GroupID: "ea167b", DomainID: "1789d1"}},
+		{"/system/users/970610/roles/9fe1d3", roles.AssignOpts{UserID: "970610", System: true}},
+		{"/system/groups/ea167b/roles/9fe1d3", roles.AssignOpts{GroupID: "ea167b", System: true}},
+		{"/OS-INHERIT/domains/1789d1/groups/ea167b/roles/9fe1d3/inherited_to_projects", roles.AssignOpts{GroupID: "ea167b", DomainID: "1789d1", InheritedToProjects: true}},
+		{"/OS-INHERIT/projects/9df1a0/users/970610/roles/9fe1d3/inherited_to_projects", roles.AssignOpts{UserID: "970610", ProjectID: "9df1a0", InheritedToProjects: true}},
+	}
+
+	for _, c := range cases {
+		testhelper.SetupHTTP()
+		HandleAssignmentSuccessfully(t, c.path)
+
+		err := roles.Assign(client.ServiceClient(), "9fe1d3", c.opts).ExtractErr()
+		testhelper.AssertNoErr(t, err)
+
+		ok, err := roles.CheckAssignment(client.ServiceClient(), "9fe1d3", c.opts)
+		testhelper.AssertNoErr(t, err)
+		testhelper.CheckEquals(t, true, ok)
+
+		err = roles.Unassign(client.ServiceClient(), "9fe1d3", c.opts).ExtractErr()
+		testhelper.AssertNoErr(t, err)
+
+		testhelper.TeardownHTTP()
+	}
+}
+
+func TestAssignOptsErrors(t *testing.T) {
+	invalid := []roles.AssignOpts{
+		{ProjectID: "9df1a0"},
+		{UserID: "970610", GroupID: "ea167b", ProjectID: "9df1a0"},
+		{UserID: "970610"},
+		{UserID: "970610", ProjectID: "9df1a0", DomainID: "1789d1"},
+		{UserID: "970610", System: true, InheritedToProjects: true},
+	}
+
+	for _, opts := range invalid {
+		err := roles.Assign(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+		if err == nil {
+			t.Errorf("expected error for %+v", opts)
+		}
+	}
+}
+
+func TestListAssignmentsOnResource(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListAssignmentsOnResourceSuccessfully(t, "/OS-INHERIT/domains/1789d1/groups/ea167b/roles/inherited_to_projects")
+
+	opts := roles.AssignOpts{GroupID: "ea167b", DomainID: "1789d1", InheritedToProjects: true}
+	allPages, err := roles.ListAssignmentsOnResource(client.ServiceClient(), opts).AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := roles.ExtractRoles(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedRolesSlice, actual)
+}
+
+func TestImpliedRoles(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+
+	inference, err := roles.ListImpliedRoles(client.ServiceClient(), "2844b2").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedRoleInference, *inference)
+
+	rule, err := roles.CreateImpliedRole(client.ServiceClient(), "2844b2", "9fe1d3").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedImpliedRole, *rule)
+
+	rule, err = roles.GetImpliedRole(client.ServiceClient(), "2844b2", "9fe1d3").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedImpliedRole, *rule)
+
+	ok, err := roles.CheckImpliedRole(client.ServiceClient(), "2844b2", "9fe1d3")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, true, ok)
+
+	inferences, err := roles.ListRoleInferences(client.ServiceClient()).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []roles.RoleInference{ExpectedRoleInference}, inferences)
+
+	err = roles.DeleteImpliedRole(client.ServiceClient(), "2844b2", "9fe1d3").ExtractErr()
+	testhelper.AssertNoErr(t, err)
+}
+
+func TestIDFromName(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleFindRoleSuccessfully(t)
+
+	id, err := roles.IDFromName(client.ServiceClient(), "admin", "1789d1")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "9fe1d3", id)
+}
@@ -2,6 +2,54 @@ package roles
 
 import "github.com/gophercloud/gophercloud"
 
+const (
+	rolePath          = "roles"
+	impliesPath       = "implies"
+	inheritPath       = "OS-INHERIT"
+	inheritedToSuffix = "inherited_to_projects"
+)
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rolePath)
+}
+
+func roleURL(client *gophercloud.ServiceClient, roleID string) string {
+	return client.ServiceURL(rolePath, roleID)
+}
+
 func listAssignmentsURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("role_assignments")
 }
+
+// assignmentBaseURL returns the URL of the roles an actor holds on a target,
+// e.g. projects/{project_id}/users/{user_id}/roles.
+func assignmentBaseURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID string, inherited bool) string {
+	parts := []string{targetType, targetID, actorType, actorID, rolePath}
+	if targetType == "system" {
+		parts = []string{targetType, actorType, actorID, rolePath}
+	}
+	if inherited {
+		parts = append([]string{inheritPath}, parts...)
+	}
+	return client.ServiceURL(parts...)
+}
+
+func assignmentURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID, roleID string, inherited bool) string {
+	url := assignmentBaseURL(client, targetType, targetID, actorType, actorID, inherited) + "/" + roleID
+	if inherited {
+		url += "/" + inheritedToSuffix
+	}
+	return url
+}
+
+func listImpliedRolesURL(client *gophercloud.ServiceClient, priorRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, impliesPath)
+}
+
+func impliedRoleURL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, impliesPath, impliedRoleID)
+}
+
+func listRoleInferencesURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("role_inferences")
+}
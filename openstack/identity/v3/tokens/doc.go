@@ -3,4 +3,20 @@
 //
 // For more information, see:
 // http://developer.openstack.org/api-ref-identity-v3.html#tokens-v3
+//
+// Example to Inspect a Token
+//
+//	result := tokens.Get(identityClient, "token")
+//
+//	project, err := result.ExtractProject()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	roles, err := result.ExtractRoles()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	fmt.Printf("Scoped to %s with roles %+v\n", project.Name, roles)
 package tokens
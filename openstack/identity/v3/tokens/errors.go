@@ -1,7 +1,10 @@
 package tokens
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 )
@@ -124,6 +127,27 @@ func (e ErrScopeProjectIDAlone) Error() string {
 	return "ProjectID must be supplied alone in a Scope"
 }
 
+// ErrScopeTrustIDAlone indicates that a TrustID was provided with other constraints in a Scope.
+type ErrScopeTrustIDAlone struct{ gophercloud.BaseError }
+
+func (e ErrScopeTrustIDAlone) Error() string {
+	return "TrustID must be supplied alone in a Scope"
+}
+
+// ErrScopeSystemAlone indicates that System was requested with other constraints in a Scope.
+type ErrScopeSystemAlone struct{ gophercloud.BaseError }
+
+func (e ErrScopeSystemAlone) Error() string {
+	return "System must be supplied alone in a Scope"
+}
+
+// ErrScopeUnscopedAlone indicates that Unscoped was requested with other constraints in a Scope.
+type ErrScopeUnscopedAlone struct{ gophercloud.BaseError }
+
+func (e ErrScopeUnscopedAlone) Error() string {
+	return "Unscoped must be supplied alone in a Scope"
+}
+
 // ErrScopeDomainName indicates that a DomainName was provided alone in a Scope.
 type ErrScopeDomainName struct{ gophercloud.BaseError }
 
@@ -135,5 +159,94 @@ func (e ErrScopeDomainName) Error() string {
 type ErrScopeEmpty struct{ gophercloud.BaseError }
 
 func (e ErrScopeEmpty) Error() string {
-	return "You must provide either a Project or Domain in a Scope"
+	return "You must provide either a Project, Domain, System, Trust or Unscoped in a Scope"
+}
+
+// ErrAppCredMissingSecret indicates that an application credential was provided without its secret.
+type ErrAppCredMissingSecret struct{ gophercloud.BaseError }
+
+func (e ErrAppCredMissingSecret) Error() string {
+	return "You must provide an ApplicationCredentialSecret to authenticate with an application credential"
+}
+
+// ErrAppCredIDOrName indicates that both an ID and a name were provided for an application credential.
+type ErrAppCredIDOrName struct{ gophercloud.BaseError }
+
+func (e ErrAppCredIDOrName) Error() string {
+	return "You must provide at most one of ApplicationCredentialID or ApplicationCredentialName"
+}
+
+// ErrUserWithAppCredID indicates that a user was provided, but unnecessary because an application credential ID is
+// being used.
+type ErrUserWithAppCredID struct{ gophercloud.BaseError }
+
+func (e ErrUserWithAppCredID) Error() string {
+	return "Username and UserID may not be provided when authenticating with an ApplicationCredentialID"
+}
+
+// ErrScopeWithAppCred indicates that a Scope was provided when authenticating with an application credential.
+type ErrScopeWithAppCred struct{ gophercloud.BaseError }
+
+func (e ErrScopeWithAppCred) Error() string {
+	return "A Scope may not be provided when authenticating with an application credential"
+}
+
+// ErrAuthReceiptRequired indicates that the user authenticated successfully with some, but not all, of the methods
+// they are required to use. The authentication can be continued by providing the missing methods along with the
+// Receipt ID.
+type ErrAuthReceiptRequired struct {
+	gophercloud.ErrDefault401
+	Receipt Receipt
+}
+
+func (e ErrAuthReceiptRequired) Error() string {
+	rules := make([]string, len(e.Receipt.RequiredAuthMethods))
+	for i, rule := range e.Receipt.RequiredAuthMethods {
+		rules[i] = strings.Join(rule, "+")
+	}
+	return fmt.Sprintf("Additional authentication methods are required: authenticated with [%s], expected one of [%s]",
+		strings.Join(e.Receipt.Methods, ", "), strings.Join(rules, ", "))
+}
+
+// receiptRequiredErr converts the error returned for a 401 response carrying an auth receipt into an
+// ErrAuthReceiptRequired. Other errors are returned unchanged.
+func receiptRequiredErr(receipt string, err error) error {
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	switch e := err.(type) {
+	case gophercloud.ErrDefault401:
+		respErr = e.ErrUnexpectedResponseCode
+	case gophercloud.ErrUnexpectedResponseCode:
+		respErr = e
+	default:
+		return err
+	}
+	if respErr.Actual != 401 {
+		return err
+	}
+
+	var s struct {
+		Receipt struct {
+			Methods   []string `json:"methods"`
+			ExpiresAt string   `json:"expires_at"`
+			User      struct {
+				ID string `json:"id"`
+			} `json:"user"`
+		} `json:"receipt"`
+		RequiredAuthMethods [][]string `json:"required_auth_methods"`
+	}
+	if jsonErr := json.Unmarshal(respErr.Body, &s); jsonErr != nil {
+		return err
+	}
+
+	e := ErrAuthReceiptRequired{
+		Receipt: Receipt{
+			ID:                  receipt,
+			Methods:             s.Receipt.Methods,
+			UserID:              s.Receipt.User.ID,
+			RequiredAuthMethods: s.RequiredAuthMethods,
+		},
+	}
+	e.ErrUnexpectedResponseCode = respErr
+	e.Receipt.ExpiresAt, _ = time.Parse(gophercloud.RFC3339Milli, s.Receipt.ExpiresAt)
+	return e
 }
@@ -2,12 +2,43 @@ package tokens
 
 import "github.com/gophercloud/gophercloud"
 
-// Scope allows a created token to be limited to a specific domain or project.
+// Scope allows a created token to be limited to a specific domain or project,
+// to the system, or to a trust. Exactly one of the following must be provided:
+//
+//	ProjectID
+//	ProjectName, with one of DomainID or DomainName
+//	DomainID
+//	System
+//	TrustID
+//	Unscoped
 type Scope struct {
-	ProjectID   string `json:"scope.project.id,omitempty" not:"ProjectName,DomainID,DomainName"`
-	ProjectName string `json:"scope.project.name,omitempty"`
-	DomainID    string `json:"scope.project.id,omitempty" not:"ProjectName,ProjectID,DomainName"`
-	DomainName  string `json:"scope.project.id,omitempty"`
+	// ProjectID scopes the token to the project with the given ID.
+	ProjectID string
+
+	// ProjectName scopes the token to the project with the given name. The
+	// project's domain must be given by DomainID or DomainName.
+	ProjectName string
+
+	// DomainID scopes the token to the domain with the given ID, unless a
+	// ProjectName is provided, in which case it identifies the domain of the
+	// project.
+	DomainID string
+
+	// DomainName identifies the domain of the project given by ProjectName.
+	DomainName string
+
+	// System scopes the token to the entire deployment, for operations that
+	// are not specific to a project or domain.
+	System bool
+
+	// TrustID scopes the token to a trust (OS-TRUST). The token then carries
+	// the roles delegated by the trust on the trust's project.
+	TrustID string
+
+	// Unscoped requests a token without any scope, even if the user has a
+	// default project. Such a token can only be used to discover the
+	// projects and domains the user may scope a token to, and to rescope.
+	Unscoped bool
 }
 
 // AuthOptionsBuilder describes any argument that may be passed to the Create call.
@@ -54,6 +85,36 @@ type AuthOptions struct {
 	// TokenID allows users to authenticate (possibly as another user) with an
 	// authentication token ID.
 	TokenID string
+
+	// ApplicationCredentialID and ApplicationCredentialSecret allow users to
+	// authenticate with an application credential instead of a password. The
+	// credential may also be identified by ApplicationCredentialName, together
+	// with either UserID or Username and one of DomainID or DomainName.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// Passcode is a time-based one-time password (TOTP) of the user. It may be
+	// provided together with Password when both are required to authenticate.
+	Passcode string `json:"-"`
+
+	// Receipt is the auth receipt returned with an ErrAuthReceiptRequired. It
+	// allows an authentication to be continued with only the methods that are
+	// still missing, e.g. with just a Passcode after a Password was accepted.
+	Receipt string `json:"-"`
+}
+
+// AuthReceiptBuilder may be implemented by an AuthOptionsBuilder to continue
+// a multi-factor authentication with an auth receipt.
+type AuthReceiptBuilder interface {
+	// ToTokenV3AuthReceipt returns the auth receipt to send with the Create
+	// request, or an empty string if there is none.
+	ToTokenV3AuthReceipt() string
+}
+
+// ToTokenV3AuthReceipt implements AuthReceiptBuilder.
+func (opts AuthOptions) ToTokenV3AuthReceipt() string {
+	return opts.Receipt
 }
 
 func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}, error) {
@@ -71,7 +132,8 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 	type userReq struct {
 		ID       *string    `json:"id,omitempty"`
 		Name     *string    `json:"name,omitempty"`
-		Password string     `json:"password"`
+		Password string     `json:"password,omitempty"`
+		Passcode string     `json:"passcode,omitempty"`
 		Domain   *domainReq `json:"domain,omitempty"`
 	}
 
@@ -79,24 +141,49 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 		User userReq `json:"user"`
 	}
 
+	type totpReq struct {
+		User userReq `json:"user"`
+	}
+
 	type tokenReq struct {
 		ID string `json:"id"`
 	}
 
+	type applicationCredentialReq struct {
+		ID     *string  `json:"id,omitempty"`
+		Name   *string  `json:"name,omitempty"`
+		User   *userReq `json:"user,omitempty"`
+		Secret string   `json:"secret"`
+	}
+
 	type identityReq struct {
-		Methods  []string     `json:"methods"`
-		Password *passwordReq `json:"password,omitempty"`
-		Token    *tokenReq    `json:"token,omitempty"`
+		Methods               []string                  `json:"methods"`
+		Password              *passwordReq              `json:"password,omitempty"`
+		Token                 *tokenReq                 `json:"token,omitempty"`
+		TOTP                  *totpReq                  `json:"totp,omitempty"`
+		ApplicationCredential *applicationCredentialReq `json:"application_credential,omitempty"`
+	}
+
+	type trustReq struct {
+		ID string `json:"id"`
+	}
+
+	type systemReq struct {
+		All bool `json:"all"`
 	}
 
 	type scopeReq struct {
 		Domain  *domainReq  `json:"domain,omitempty"`
 		Project *projectReq `json:"project,omitempty"`
+		System  *systemReq  `json:"system,omitempty"`
+		Trust   *trustReq   `json:"OS-TRUST:trust,omitempty"`
 	}
 
 	type authReq struct {
 		Identity identityReq `json:"identity"`
-		Scope    *scopeReq   `json:"scope,omitempty"`
+
+		// Scope is either a *scopeReq or the string "unscoped".
+		Scope interface{} `json:"scope,omitempty"`
 	}
 
 	type request struct {
@@ -115,7 +202,7 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 		return nil, ErrTenantNameProvided{}
 	}
 
-	if opts.Password == "" {
+	if opts.Password == "" && opts.Passcode == "" {
 		if opts.TokenID != "" {
 			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
 			// parameters.
@@ -137,13 +224,67 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 			req.Auth.Identity.Token = &tokenReq{
 				ID: opts.TokenID,
 			}
+		} else if opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != "" {
+			// Application credential authentication. The credential is identified either by its ID alone, or by its
+			// name together with the user that owns it.
+			if opts.ApplicationCredentialSecret == "" {
+				return nil, ErrAppCredMissingSecret{}
+			}
+
+			appCred := &applicationCredentialReq{Secret: opts.ApplicationCredentialSecret}
+			if opts.ApplicationCredentialID != "" {
+				if opts.ApplicationCredentialName != "" {
+					return nil, ErrAppCredIDOrName{}
+				}
+				if opts.Username != "" || opts.UserID != "" {
+					return nil, ErrUserWithAppCredID{}
+				}
+				appCred.ID = &opts.ApplicationCredentialID
+			} else {
+				appCred.Name = &opts.ApplicationCredentialName
+
+				if opts.UserID != "" {
+					if opts.Username != "" {
+						return nil, ErrUsernameOrUserID{}
+					}
+					if opts.DomainID != "" {
+						return nil, ErrDomainIDWithUserID{}
+					}
+					if opts.DomainName != "" {
+						return nil, ErrDomainNameWithUserID{}
+					}
+					appCred.User = &userReq{ID: &opts.UserID}
+				} else {
+					if opts.Username == "" {
+						return nil, ErrUsernameOrUserID{}
+					}
+					if (opts.DomainID == "") == (opts.DomainName == "") {
+						return nil, ErrDomainIDOrDomainName{}
+					}
+					appCred.User = &userReq{Name: &opts.Username}
+					if opts.DomainID != "" {
+						appCred.User.Domain = &domainReq{ID: &opts.DomainID}
+					} else {
+						appCred.User.Domain = &domainReq{Name: &opts.DomainName}
+					}
+				}
+			}
+
+			// Application credentials are bound to the project they were created in, so the token may not be
+			// scoped explicitly.
+			if scope != nil {
+				return nil, ErrScopeWithAppCred{}
+			}
+
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = appCred
 		} else {
 			// If no password or token ID are available, authentication can't continue.
 			return nil, ErrMissingPassword{}
 		}
 	} else {
-		// Password authentication.
-		req.Auth.Identity.Methods = []string{"password"}
+		// Password and/or TOTP authentication. Both methods identify the user in the same way.
+		var user userReq
 
 		// At least one of Username and UserID must be specified.
 		if opts.Username == "" && opts.UserID == "" {
@@ -166,25 +307,13 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 					return nil, ErrDomainIDOrDomainName{}
 				}
 
-				// Configure the request for Username and Password authentication with a DomainID.
-				req.Auth.Identity.Password = &passwordReq{
-					User: userReq{
-						Name:     &opts.Username,
-						Password: opts.Password,
-						Domain:   &domainReq{ID: &opts.DomainID},
-					},
-				}
+				// Identify the user by Username and DomainID.
+				user = userReq{Name: &opts.Username, Domain: &domainReq{ID: &opts.DomainID}}
 			}
 
 			if opts.DomainName != "" {
-				// Configure the request for Username and Password authentication with a DomainName.
-				req.Auth.Identity.Password = &passwordReq{
-					User: userReq{
-						Name:     &opts.Username,
-						Password: opts.Password,
-						Domain:   &domainReq{Name: &opts.DomainName},
-					},
-				}
+				// Identify the user by Username and DomainName.
+				user = userReq{Name: &opts.Username, Domain: &domainReq{Name: &opts.DomainName}}
 			}
 		}
 
@@ -197,21 +326,66 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 				return nil, ErrDomainNameWithUserID{}
 			}
 
-			// Configure the request for UserID and Password authentication.
-			req.Auth.Identity.Password = &passwordReq{
-				User: userReq{ID: &opts.UserID, Password: opts.Password},
-			}
+			// Identify the user by UserID.
+			user = userReq{ID: &opts.UserID}
+		}
+
+		if opts.Password != "" {
+			passwordUser := user
+			passwordUser.Password = opts.Password
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "password")
+			req.Auth.Identity.Password = &passwordReq{User: passwordUser}
+		}
+
+		if opts.Passcode != "" {
+			totpUser := user
+			totpUser.Passcode = opts.Passcode
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "totp")
+			req.Auth.Identity.TOTP = &totpReq{User: totpUser}
 		}
 	}
 
 	// Add a "scope" element if a Scope has been provided.
 	if scope != nil {
-		if scope.ProjectName != "" {
-			// ProjectName provided: either DomainID or DomainName must also be supplied.
+		if scope.Unscoped {
+			// Unscoped must be supplied alone.
+			if scope.ProjectID != "" || scope.ProjectName != "" || scope.DomainID != "" || scope.DomainName != "" ||
+				scope.System || scope.TrustID != "" {
+				return nil, ErrScopeUnscopedAlone{}
+			}
+
+			// Unscoped
+			req.Auth.Scope = "unscoped"
+		} else if scope.System {
+			// System provided. ProjectID, ProjectName, DomainID, DomainName, and TrustID may not be provided.
+			if scope.ProjectID != "" || scope.ProjectName != "" || scope.DomainID != "" || scope.DomainName != "" ||
+				scope.TrustID != "" {
+				return nil, ErrScopeSystemAlone{}
+			}
+
+			// System
+			req.Auth.Scope = &scopeReq{
+				System: &systemReq{All: true},
+			}
+		} else if scope.TrustID != "" {
+			// TrustID provided. ProjectID, ProjectName, DomainID, and DomainName may not be provided.
+			if scope.ProjectID != "" || scope.ProjectName != "" || scope.DomainID != "" || scope.DomainName != "" {
+				return nil, ErrScopeTrustIDAlone{}
+			}
+
+			// TrustID
+			req.Auth.Scope = &scopeReq{
+				Trust: &trustReq{ID: scope.TrustID},
+			}
+		} else if scope.ProjectName != "" {
+			// ProjectName provided: exactly one of DomainID or DomainName must also be supplied.
 			// ProjectID may not be supplied.
 			if scope.DomainID == "" && scope.DomainName == "" {
 				return nil, ErrScopeDomainIDOrDomainName{}
 			}
+			if scope.DomainID != "" && scope.DomainName != "" {
+				return nil, ErrScopeDomainIDOrDomainName{}
+			}
 			if scope.ProjectID != "" {
 				return nil, ErrScopeProjectIDOrProjectName{}
 			}
@@ -272,6 +446,9 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 	return b, nil
 }
 
+// receiptHeader is the header an auth receipt is returned and sent back in.
+const receiptHeader = "Openstack-Auth-Receipt"
+
 func subjectTokenHeaders(c *gophercloud.ServiceClient, subjectToken string) map[string]string {
 	return map[string]string{
 		"X-Subject-Token": subjectToken,
@@ -279,18 +456,31 @@ func subjectTokenHeaders(c *gophercloud.ServiceClient, subjectToken string) map[
 }
 
 // Create authenticates and either generates a new token, or changes the Scope of an existing token.
+//
+// If the user authenticated successfully with some, but not all, of the methods
+// they are required to use, the error is an ErrAuthReceiptRequired describing
+// the methods that are still missing.
 func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder, scopeOpts *Scope) (r CreateResult) {
 	b, err := opts.ToTokenV3CreateMap(scopeOpts)
 	if err != nil {
 		r.Err = err
 		return
 	}
+	h := map[string]string{"X-Auth-Token": ""}
+	if rb, ok := opts.(AuthReceiptBuilder); ok {
+		if receipt := rb.ToTokenV3AuthReceipt(); receipt != "" {
+			h[receiptHeader] = receipt
+		}
+	}
 	resp, err := c.Post(tokenURL(c), b, &r.Body, &gophercloud.RequestOpts{
-		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		MoreHeaders: h,
 	})
 	r.Err = err
 	if resp != nil {
 		r.Header = resp.Header
+		if err != nil && resp.Header.Get(receiptHeader) != "" {
+			r.Err = receiptRequiredErr(resp.Header.Get(receiptHeader), err)
+		}
 	}
 	return
 }
@@ -310,7 +500,7 @@ func Get(c *gophercloud.ServiceClient, token string) (r GetResult) {
 
 // Validate determines if a specified token is valid or not.
 func Validate(c *gophercloud.ServiceClient, token string) (bool, error) {
-	resp, err := c.Request("HEAD", tokenURL(c), &gophercloud.RequestOpts{
+	resp, err := c.Head(tokenURL(c), &gophercloud.RequestOpts{
 		MoreHeaders: subjectTokenHeaders(c, token),
 		OkCodes:     []int{204, 404},
 	})
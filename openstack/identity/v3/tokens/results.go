@@ -91,6 +91,189 @@ func (r CreateResult) ExtractServiceCatalog() (*ServiceCatalog, error) {
 	return &ServiceCatalog{Entries: s.Token.Entries}, err
 }
 
+// Domain is the domain of a user, of a project, or of a domain-scoped token.
+type Domain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// User is the user a token was issued to.
+type User struct {
+	Domain Domain `json:"domain"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+}
+
+// Project is the project of a project-scoped token.
+type Project struct {
+	Domain Domain `json:"domain"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+}
+
+// Role is a role a token carries on its scope.
+type Role struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TrustUser identifies the trustor or trustee of a trust.
+type TrustUser struct {
+	ID string `json:"id"`
+}
+
+// Trust is the trust of a trust-scoped token.
+type Trust struct {
+	ID                 string    `json:"id"`
+	Impersonation      bool      `json:"impersonation"`
+	TrusteeUser        TrustUser `json:"trustee_user"`
+	TrustorUser        TrustUser `json:"trustor_user"`
+	RedelegatedTrustID string    `json:"redelegated_trust_id"`
+	RedelegationCount  int       `json:"redelegation_count"`
+}
+
+// ApplicationCredential is the application credential a token was obtained
+// with.
+type ApplicationCredential struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Restricted bool   `json:"restricted"`
+}
+
+// tokenInfo is the body of a token, less its service catalog.
+type tokenInfo struct {
+	Methods               []string               `json:"methods"`
+	User                  *User                  `json:"user"`
+	Project               *Project               `json:"project"`
+	Domain                *Domain                `json:"domain"`
+	System                map[string]bool        `json:"system"`
+	Roles                 []Role                 `json:"roles"`
+	IssuedAt              string                 `json:"issued_at"`
+	ExpiresAt             string                 `json:"expires_at"`
+	AuditIDs              []string               `json:"audit_ids"`
+	Trust                 *Trust                 `json:"OS-TRUST:trust"`
+	ApplicationCredential *ApplicationCredential `json:"application_credential"`
+}
+
+func (r commonResult) extractInfo() (*tokenInfo, error) {
+	var s struct {
+		Token tokenInfo `json:"token"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Token, err
+}
+
+// ExtractUser returns the user the token was issued to.
+func (r commonResult) ExtractUser() (*User, error) {
+	info, err := r.extractInfo()
+	return info.User, err
+}
+
+// ExtractProject returns the project of a project-scoped token, or nil for
+// other tokens.
+func (r commonResult) ExtractProject() (*Project, error) {
+	info, err := r.extractInfo()
+	return info.Project, err
+}
+
+// ExtractDomain returns the domain of a domain-scoped token, or nil for other
+// tokens.
+func (r commonResult) ExtractDomain() (*Domain, error) {
+	info, err := r.extractInfo()
+	return info.Domain, err
+}
+
+// ExtractSystem reports whether the token is scoped to the system.
+func (r commonResult) ExtractSystem() (bool, error) {
+	info, err := r.extractInfo()
+	return info.System["all"], err
+}
+
+// ExtractRoles returns the roles the token carries on its scope. Unscoped
+// tokens carry no roles.
+func (r commonResult) ExtractRoles() ([]Role, error) {
+	info, err := r.extractInfo()
+	return info.Roles, err
+}
+
+// ExtractMethods returns the methods the user authenticated with to obtain
+// the token, e.g. "password" or "application_credential".
+func (r commonResult) ExtractMethods() ([]string, error) {
+	info, err := r.extractInfo()
+	return info.Methods, err
+}
+
+// ExtractIssuedAt returns the time at which the token was issued.
+func (r commonResult) ExtractIssuedAt() (time.Time, error) {
+	info, err := r.extractInfo()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(gophercloud.RFC3339Milli, info.IssuedAt)
+}
+
+// ExtractAuditIDs returns the audit IDs of the token. The first one
+// identifies the token itself, the second one, if any, the chain of tokens it
+// was rescoped from.
+func (r commonResult) ExtractAuditIDs() ([]string, error) {
+	info, err := r.extractInfo()
+	return info.AuditIDs, err
+}
+
+// ExtractTrust returns the trust of a trust-scoped token, or nil for other
+// tokens.
+func (r commonResult) ExtractTrust() (*Trust, error) {
+	info, err := r.extractInfo()
+	return info.Trust, err
+}
+
+// ExtractApplicationCredential returns the application credential the token
+// was obtained with, or nil if it was obtained otherwise.
+func (r commonResult) ExtractApplicationCredential() (*ApplicationCredential, error) {
+	info, err := r.extractInfo()
+	return info.ApplicationCredential, err
+}
+
+// ExtractAuthIdentity summarizes who the token was issued to, its scope and
+// its roles, as kept by a ProviderClient.
+func (r commonResult) ExtractAuthIdentity() (*gophercloud.AuthIdentity, error) {
+	info, err := r.extractInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &gophercloud.AuthIdentity{
+		Methods: info.Methods,
+		System:  info.System["all"],
+	}
+	if info.User != nil {
+		identity.UserID = info.User.ID
+		identity.UserName = info.User.Name
+		identity.UserDomainID = info.User.Domain.ID
+	}
+	if info.Project != nil {
+		identity.ProjectID = info.Project.ID
+		identity.ProjectName = info.Project.Name
+		identity.ProjectDomainID = info.Project.Domain.ID
+	}
+	if info.Domain != nil {
+		identity.DomainID = info.Domain.ID
+		identity.DomainName = info.Domain.Name
+	}
+	for _, role := range info.Roles {
+		identity.Roles = append(identity.Roles, gophercloud.AuthRole{ID: role.ID, Name: role.Name})
+	}
+	if info.Trust != nil {
+		identity.TrustID = info.Trust.ID
+	}
+	if info.ApplicationCredential != nil {
+		identity.ApplicationCredentialID = info.ApplicationCredential.ID
+	}
+	identity.ExpiresAt, err = time.Parse(gophercloud.RFC3339Milli, info.ExpiresAt)
+
+	return identity, err
+}
+
 // CreateResult defers the interpretation of a created token.
 // Use ExtractToken() to interpret it as a Token, or ExtractServiceCatalog() to interpret it as a service catalog.
 type CreateResult struct {
@@ -123,3 +306,46 @@ type Token struct {
 	// ExpiresAt is the timestamp at which this token will no longer be accepted.
 	ExpiresAt time.Time
 }
+
+// Receipt describes a partially successful multi-factor authentication. It is
+// returned in an ErrAuthReceiptRequired.
+type Receipt struct {
+	// ID is the receipt itself. Pass it in AuthOptions.Receipt, along with the
+	// missing methods, to continue the authentication.
+	ID string
+
+	// Methods lists the methods the user has already authenticated with.
+	Methods []string
+
+	// UserID is the ID of the user being authenticated.
+	UserID string
+
+	// ExpiresAt is the timestamp at which the receipt will no longer be
+	// accepted.
+	ExpiresAt time.Time
+
+	// RequiredAuthMethods lists the auth rules configured for the user. Each
+	// rule is a set of methods which, used together, authenticate the user.
+	RequiredAuthMethods [][]string
+}
+
+// MissingMethods returns, for each auth rule of the receipt, the methods the
+// user has yet to authenticate with to satisfy it.
+func (r Receipt) MissingMethods() [][]string {
+	done := make(map[string]bool, len(r.Methods))
+	for _, m := range r.Methods {
+		done[m] = true
+	}
+
+	missing := make([][]string, 0, len(r.RequiredAuthMethods))
+	for _, rule := range r.RequiredAuthMethods {
+		var methods []string
+		for _, m := range rule {
+			if !done[m] {
+				methods = append(methods, m)
+			}
+		}
+		missing = append(missing, methods)
+	}
+	return missing
+}
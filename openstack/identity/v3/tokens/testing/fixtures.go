@@ -0,0 +1,312 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ProjectScopedTokenOutput is a sample response to a token request scoped to
+// a project.
+const ProjectScopedTokenOutput = `
+{
+    "token": {
+        "methods": [
+            "password"
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "ee4dfb6e5540447cb3741905149d9b6e",
+            "name": "admin",
+            "password_expires_at": null
+        },
+        "audit_ids": [
+            "3T2dc1CGQxyJsHdDu1xkcw"
+        ],
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-02T02:19:49.000000Z",
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "a99e9b4e620e4db09a2dfb6e42a01e66",
+            "name": "admin"
+        },
+        "is_domain": false,
+        "roles": [
+            {
+                "id": "51cc68287d524c759f47c811e6463340",
+                "name": "admin"
+            },
+            {
+                "id": "9fe2ff9ee4384b1894a90878d3e92bab",
+                "name": "member"
+            }
+        ],
+        "catalog": []
+    }
+}
+`
+
+// TrustScopedTokenOutput is a sample response to a token request scoped to
+// a trust.
+const TrustScopedTokenOutput = `
+{
+    "token": {
+        "methods": [
+            "password"
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "3ec3164f750146be97f21559ee4d9c51",
+            "name": "trustee"
+        },
+        "audit_ids": [
+            "5X7VymOaTz2UgcBxR9qZ9A",
+            "3T2dc1CGQxyJsHdDu1xkcw"
+        ],
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-02T02:19:49.000000Z",
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "a99e9b4e620e4db09a2dfb6e42a01e66",
+            "name": "admin"
+        },
+        "roles": [
+            {
+                "id": "9fe2ff9ee4384b1894a90878d3e92bab",
+                "name": "member"
+            }
+        ],
+        "OS-TRUST:trust": {
+            "id": "fe0aef",
+            "impersonation": false,
+            "redelegated_trust_id": "3ba234",
+            "redelegation_count": 2,
+            "trustee_user": {
+                "id": "3ec3164f750146be97f21559ee4d9c51"
+            },
+            "trustor_user": {
+                "id": "ee4dfb6e5540447cb3741905149d9b6e"
+            }
+        }
+    }
+}
+`
+
+// ApplicationCredentialTokenOutput is a sample response to a token request
+// authenticated with an application credential.
+const ApplicationCredentialTokenOutput = `
+{
+    "token": {
+        "methods": [
+            "application_credential"
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "ee4dfb6e5540447cb3741905149d9b6e",
+            "name": "admin"
+        },
+        "audit_ids": [
+            "QUrbMzvSRzOZLsGzyjQMbg"
+        ],
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-02T02:19:49.000000Z",
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "a99e9b4e620e4db09a2dfb6e42a01e66",
+            "name": "admin"
+        },
+        "roles": [
+            {
+                "id": "9fe2ff9ee4384b1894a90878d3e92bab",
+                "name": "member"
+            }
+        ],
+        "application_credential": {
+            "id": "c7ee1c6d3f1b4a5c9e1d3e1bd5e6a2f8",
+            "name": "monitoring",
+            "restricted": true
+        }
+    }
+}
+`
+
+// DomainScopedTokenOutput is a sample response to a token request scoped to
+// a domain.
+const DomainScopedTokenOutput = `
+{
+    "token": {
+        "methods": [
+            "password"
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "ee4dfb6e5540447cb3741905149d9b6e",
+            "name": "admin"
+        },
+        "audit_ids": [
+            "3T2dc1CGQxyJsHdDu1xkcw"
+        ],
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-02T02:19:49.000000Z",
+        "domain": {
+            "id": "default",
+            "name": "Default"
+        },
+        "roles": [
+            {
+                "id": "51cc68287d524c759f47c811e6463340",
+                "name": "admin"
+            }
+        ]
+    }
+}
+`
+
+// SystemScopedTokenOutput is a sample response to a token request scoped to
+// the system.
+const SystemScopedTokenOutput = `
+{
+    "token": {
+        "methods": [
+            "password"
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "ee4dfb6e5540447cb3741905149d9b6e",
+            "name": "admin"
+        },
+        "audit_ids": [
+            "3T2dc1CGQxyJsHdDu1xkcw"
+        ],
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-02T02:19:49.000000Z",
+        "system": {
+            "all": true
+        },
+        "roles": [
+            {
+                "id": "51cc68287d524c759f47c811e6463340",
+                "name": "admin"
+            }
+        ]
+    }
+}
+`
+
+// ExpectedUser is the user of every sample token but the trust-scoped one.
+var ExpectedUser = tokens.User{
+	Domain: tokens.Domain{
+		ID:   "default",
+		Name: "Default",
+	},
+	ID:   "ee4dfb6e5540447cb3741905149d9b6e",
+	Name: "admin",
+}
+
+// ExpectedProject is the project of the project-scoped sample tokens.
+var ExpectedProject = tokens.Project{
+	Domain: tokens.Domain{
+		ID:   "default",
+		Name: "Default",
+	},
+	ID:   "a99e9b4e620e4db09a2dfb6e42a01e66",
+	Name: "admin",
+}
+
+// ExpectedRoles are the roles of ProjectScopedTokenOutput.
+var ExpectedRoles = []tokens.Role{
+	{
+		ID:   "51cc68287d524c759f47c811e6463340",
+		Name: "admin",
+	},
+	{
+		ID:   "9fe2ff9ee4384b1894a90878d3e92bab",
+		Name: "member",
+	},
+}
+
+// ExpectedTrust is the trust of TrustScopedTokenOutput.
+var ExpectedTrust = tokens.Trust{
+	ID:                 "fe0aef",
+	Impersonation:      false,
+	TrusteeUser:        tokens.TrustUser{ID: "3ec3164f750146be97f21559ee4d9c51"},
+	TrustorUser:        tokens.TrustUser{ID: "ee4dfb6e5540447cb3741905149d9b6e"},
+	RedelegatedTrustID: "3ba234",
+	RedelegationCount:  2,
+}
+
+// ExpectedApplicationCredential is the application credential of
+// ApplicationCredentialTokenOutput.
+var ExpectedApplicationCredential = tokens.ApplicationCredential{
+	ID:         "c7ee1c6d3f1b4a5c9e1d3e1bd5e6a2f8",
+	Name:       "monitoring",
+	Restricted: true,
+}
+
+// ExpectedAuthIdentity is the identity summarized from
+// ProjectScopedTokenOutput.
+var ExpectedAuthIdentity = gophercloud.AuthIdentity{
+	UserID:          "ee4dfb6e5540447cb3741905149d9b6e",
+	UserName:        "admin",
+	UserDomainID:    "default",
+	ProjectID:       "a99e9b4e620e4db09a2dfb6e42a01e66",
+	ProjectName:     "admin",
+	ProjectDomainID: "default",
+	Roles: []gophercloud.AuthRole{
+		{
+			ID:   "51cc68287d524c759f47c811e6463340",
+			Name: "admin",
+		},
+		{
+			ID:   "9fe2ff9ee4384b1894a90878d3e92bab",
+			Name: "member",
+		},
+	},
+	Methods:   []string{"password"},
+	ExpiresAt: time.Date(2017, 6, 3, 2, 19, 49, 0, time.UTC),
+}
+
+// HandleGetTokenSuccessfully creates an HTTP handler at `/auth/tokens` on the
+// test handler mux that responds to the validation of a token with output.
+func HandleGetTokenSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "X-Subject-Token", "abcdef12345")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, output)
+	})
+}
@@ -134,6 +134,172 @@ func TestCreateTokenID(t *testing.T) {
 	`)
 }
 
+func TestCreatePasswordAndPasscode(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!", Passcode: "123456"}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password", "totp"],
+					"password": {
+						"user": { "id": "me", "password": "squirrel!" }
+					},
+					"totp": {
+						"user": { "id": "me", "passcode": "123456" }
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateReceiptRequired(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+
+		if r.Header.Get("Openstack-Auth-Receipt") == "" {
+			testhelper.TestJSONRequest(t, r, `
+				{
+					"auth": {
+						"identity": {
+							"methods": ["password"],
+							"password": {
+								"user": { "name": "fakeusername", "password": "fakepassword", "domain": { "name": "default" } }
+							}
+						}
+					}
+				}
+			`)
+
+			w.Header().Add("Openstack-Auth-Receipt", "gAAAAABbSrv2b6sxIz")
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintf(w, `{
+				"receipt": {
+					"methods": ["password"],
+					"user": { "id": "ee4dfb6e5540447cb3741905149d9b6e", "name": "fakeusername" },
+					"expires_at": "2014-10-02T13:50:00.000000Z",
+					"issued_at": "2014-10-02T13:45:00.000000Z"
+				},
+				"required_auth_methods": [["password", "totp"], ["password", "custom-auth"]]
+			}`)
+			return
+		}
+
+		testhelper.TestHeader(t, r, "Openstack-Auth-Receipt", "gAAAAABbSrv2b6sxIz")
+		testhelper.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["totp"],
+						"totp": {
+							"user": { "name": "fakeusername", "passcode": "123456", "domain": { "name": "default" } }
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "aaa111")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2014-10-02T13:45:00.000000Z"
+			}
+		}`)
+	})
+
+	options := tokens.AuthOptions{Username: "fakeusername", Password: "fakepassword", DomainName: "default"}
+	_, err := tokens.Create(&client, options, nil).ExtractToken()
+	receiptErr, ok := err.(tokens.ErrAuthReceiptRequired)
+	if !ok {
+		t.Fatalf("Expected ErrAuthReceiptRequired, got %v", err)
+	}
+
+	receipt := receiptErr.Receipt
+	testhelper.CheckEquals(t, "gAAAAABbSrv2b6sxIz", receipt.ID)
+	testhelper.CheckEquals(t, "ee4dfb6e5540447cb3741905149d9b6e", receipt.UserID)
+	testhelper.CheckDeepEquals(t, []string{"password"}, receipt.Methods)
+	testhelper.CheckEquals(t, time.Date(2014, 10, 2, 13, 50, 0, 0, time.UTC), receipt.ExpiresAt)
+	testhelper.CheckDeepEquals(t, [][]string{{"totp"}, {"custom-auth"}}, receipt.MissingMethods())
+
+	options.Password = ""
+	options.Passcode = "123456"
+	options.Receipt = receipt.ID
+	token, err := tokens.Create(&client, options, nil).ExtractToken()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "aaa111", token.ID)
+}
+
+func TestCreateApplicationCredentialID(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": { "id": "12345abcdef", "secret": "mysecret" }
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialNameUserID(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialName:   "myappcred",
+		ApplicationCredentialSecret: "mysecret",
+		UserID:                      "me",
+	}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": { "id": "me" }
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialNameUsernameDomainName(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialName:   "myappcred",
+		ApplicationCredentialSecret: "mysecret",
+		Username:                    "fakeusername",
+		DomainName:                  "default",
+	}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": {
+							"name": "fakeusername",
+							"domain": { "name": "default" }
+						}
+					}
+				}
+			}
+		}
+	`)
+}
+
 func TestCreateProjectIDScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
 	scope := &tokens.Scope{ProjectID: "123456"}
@@ -240,6 +406,68 @@ func TestCreateProjectNameAndDomainNameScope(t *testing.T) {
 	`)
 }
 
+func TestCreateSystemScope(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	scope := &tokens.Scope{System: true}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": { "id": "me", "password": "squirrel!" }
+					}
+				},
+				"scope": {
+					"system": {
+						"all": true
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateUnscoped(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	scope := &tokens.Scope{Unscoped: true}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": { "id": "me", "password": "squirrel!" }
+					}
+				},
+				"scope": "unscoped"
+			}
+		}
+	`)
+}
+
+func TestCreateTrustIDScope(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	scope := &tokens.Scope{TrustID: "987fe7"}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": { "id": "me", "password": "squirrel!" }
+					}
+				},
+				"scope": {
+					"OS-TRUST:trust": {
+						"id": "987fe7"
+					}
+				}
+			}
+		}
+	`)
+}
+
 func TestCreateExtractsTokenFromResponse(t *testing.T) {
 	testhelper.SetupHTTP()
 	defer testhelper.TeardownHTTP()
@@ -385,12 +613,85 @@ func TestCreateFailureScopeDomainNameAlone(t *testing.T) {
 	authTokenPostErr(t, options, scope, false, tokens.ErrScopeDomainName{})
 }
 
+func TestCreateFailureScopeTrustIDAndProjectID(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	scope := &tokens.Scope{TrustID: "987fe7", ProjectID: "123456"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeTrustIDAlone{})
+}
+
+func TestCreateFailureScopeProjectNameAndBothDomains(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{ProjectName: "myproject", DomainID: "123456", DomainName: "default"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeDomainIDOrDomainName{})
+}
+
+func TestCreateFailureScopeSystemAndProjectID(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{System: true, ProjectID: "123456"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeSystemAlone{})
+}
+
+func TestCreateFailureScopeUnscopedAndDomainID(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{Unscoped: true, DomainID: "123456"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeUnscopedAlone{})
+}
+
 func TestCreateFailureEmptyScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
 	scope := &tokens.Scope{}
 	authTokenPostErr(t, options, scope, false, tokens.ErrScopeEmpty{})
 }
 
+func TestCreateFailureAppCredMissingSecret(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef"}
+	authTokenPostErr(t, options, nil, false, tokens.ErrAppCredMissingSecret{})
+}
+
+func TestCreateFailureAppCredIDAndName(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialID:     "12345abcdef",
+		ApplicationCredentialName:   "myappcred",
+		ApplicationCredentialSecret: "mysecret",
+	}
+	authTokenPostErr(t, options, nil, false, tokens.ErrAppCredIDOrName{})
+}
+
+func TestCreateFailureAppCredIDAndUser(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialID:     "12345abcdef",
+		ApplicationCredentialSecret: "mysecret",
+		UserID:                      "me",
+	}
+	authTokenPostErr(t, options, nil, false, tokens.ErrUserWithAppCredID{})
+}
+
+func TestCreateFailureAppCredNameMissingUser(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialName:   "myappcred",
+		ApplicationCredentialSecret: "mysecret",
+	}
+	authTokenPostErr(t, options, nil, false, tokens.ErrUsernameOrUserID{})
+}
+
+func TestCreateFailureAppCredNameMissingDomain(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialName:   "myappcred",
+		ApplicationCredentialSecret: "mysecret",
+		Username:                    "fakeusername",
+	}
+	authTokenPostErr(t, options, nil, false, tokens.ErrDomainIDOrDomainName{})
+}
+
+func TestCreateFailureAppCredScope(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialID:     "12345abcdef",
+		ApplicationCredentialSecret: "mysecret",
+	}
+	scope := &tokens.Scope{ProjectID: "123456"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeWithAppCred{})
+}
+
 func TestGetRequest(t *testing.T) {
 	testhelper.SetupHTTP()
 	defer testhelper.TeardownHTTP()
@@ -0,0 +1,141 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestExtractProjectScopedToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTokenSuccessfully(t, ProjectScopedTokenOutput)
+
+	result := tokens.Get(client.ServiceClient(), "abcdef12345")
+
+	user, err := result.ExtractUser()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedUser, *user)
+
+	project, err := result.ExtractProject()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProject, *project)
+
+	domain, err := result.ExtractDomain()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, domain == nil)
+
+	system, err := result.ExtractSystem()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, system)
+
+	roles, err := result.ExtractRoles()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRoles, roles)
+
+	methods, err := result.ExtractMethods()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"password"}, methods)
+
+	issuedAt, err := result.ExtractIssuedAt()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, time.Date(2017, 6, 2, 2, 19, 49, 0, time.UTC), issuedAt)
+
+	auditIDs, err := result.ExtractAuditIDs()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"3T2dc1CGQxyJsHdDu1xkcw"}, auditIDs)
+
+	trust, err := result.ExtractTrust()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, trust == nil)
+
+	appCred, err := result.ExtractApplicationCredential()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, appCred == nil)
+
+	identity, err := result.ExtractAuthIdentity()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedAuthIdentity, *identity)
+	th.CheckEquals(t, true, identity.HasRole("member"))
+	th.CheckEquals(t, false, identity.HasRole("reader"))
+}
+
+func TestExtractTrustScopedToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTokenSuccessfully(t, TrustScopedTokenOutput)
+
+	result := tokens.Get(client.ServiceClient(), "abcdef12345")
+
+	trust, err := result.ExtractTrust()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedTrust, *trust)
+
+	auditIDs, err := result.ExtractAuditIDs()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"5X7VymOaTz2UgcBxR9qZ9A", "3T2dc1CGQxyJsHdDu1xkcw"}, auditIDs)
+
+	identity, err := result.ExtractAuthIdentity()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "3ec3164f750146be97f21559ee4d9c51", identity.UserID)
+	th.CheckEquals(t, "fe0aef", identity.TrustID)
+}
+
+func TestExtractApplicationCredentialToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTokenSuccessfully(t, ApplicationCredentialTokenOutput)
+
+	result := tokens.Get(client.ServiceClient(), "abcdef12345")
+
+	appCred, err := result.ExtractApplicationCredential()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedApplicationCredential, *appCred)
+
+	identity, err := result.ExtractAuthIdentity()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"application_credential"}, identity.Methods)
+	th.CheckEquals(t, "c7ee1c6d3f1b4a5c9e1d3e1bd5e6a2f8", identity.ApplicationCredentialID)
+}
+
+func TestExtractDomainScopedToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTokenSuccessfully(t, DomainScopedTokenOutput)
+
+	result := tokens.Get(client.ServiceClient(), "abcdef12345")
+
+	domain, err := result.ExtractDomain()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, tokens.Domain{ID: "default", Name: "Default"}, *domain)
+
+	project, err := result.ExtractProject()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, project == nil)
+
+	identity, err := result.ExtractAuthIdentity()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "default", identity.DomainID)
+	th.CheckEquals(t, "Default", identity.DomainName)
+	th.CheckEquals(t, "", identity.ProjectID)
+}
+
+func TestExtractSystemScopedToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTokenSuccessfully(t, SystemScopedTokenOutput)
+
+	result := tokens.Get(client.ServiceClient(), "abcdef12345")
+
+	system, err := result.ExtractSystem()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, system)
+
+	identity, err := result.ExtractAuthIdentity()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, identity.System)
+	th.CheckEquals(t, true, identity.HasRole("admin"))
+}
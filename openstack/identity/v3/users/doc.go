@@ -0,0 +1,72 @@
+/*
+Package users provides information and interaction with the users API
+resource for the OpenStack Identity service.
+
+Example to List Users whose Password Expires Soon
+
+	listOpts := users.ListOpts{
+		DomainID:          "default",
+		PasswordExpiresAt: "lt:2027-01-01T00:00:00Z",
+	}
+
+	allPages, err := users.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allUsers, err := users.ExtractUsers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Service User
+
+	ignore := true
+	createOpts := users.CreateOpts{
+		Name:             "ci-runner",
+		DomainID:         "default",
+		DefaultProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66",
+		Password:         "secretsecret",
+		Options: &users.Options{
+			IgnorePasswordExpiry: &ignore,
+		},
+	}
+
+	user, err := users.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Change the Password of the Current User
+
+	changePasswordOpts := users.ChangePasswordOpts{
+		OriginalPassword: "secretsecret",
+		Password:         "new-secretsecret",
+	}
+
+	err := users.ChangePassword(identityClient, userID, changePasswordOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Enabled Members of a Group
+
+	enabled := true
+	allPages, err := users.ListInGroup(identityClient, groupID, users.ListOpts{Enabled: &enabled}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	members, err := users.ExtractUsers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove a User from a Group
+
+	err := users.RemoveFromGroup(identityClient, groupID, userID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package users
@@ -0,0 +1,294 @@
+package users
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToUserListQuery() (string, error)
+}
+
+// ListOpts allows you to query the List method.
+type ListOpts struct {
+	// DomainID filters the users by the domain they belong to.
+	DomainID string `q:"domain_id"`
+
+	// Name filters the users by name.
+	Name string `q:"name"`
+
+	// Enabled, if set, filters the users by whether they are enabled.
+	Enabled *bool
+
+	// PasswordExpiresAt filters the users by the expiry of their password. It
+	// has the form "{operator}:{timestamp}", where the operator is one of lt,
+	// lte, gt, gte, eq and neq, e.g. "lt:2027-01-01T00:00:00Z".
+	PasswordExpiresAt string `q:"password_expires_at"`
+
+	// IdPID filters the users by the identity provider they are federated
+	// from.
+	IdPID string `q:"idp_id"`
+
+	// ProtocolID filters the users by the federation protocol they
+	// authenticate with.
+	ProtocolID string `q:"protocol_id"`
+
+	// UniqueID filters the users by their unique ID at their identity
+	// provider.
+	UniqueID string `q:"unique_id"`
+}
+
+// ToUserListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToUserListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	// BuildQueryString omits false values, which are meaningful filters here.
+	if opts.Enabled != nil {
+		params := q.Query()
+		params.Set("enabled", strconv.FormatBool(*opts.Enabled))
+		q.RawQuery = params.Encode()
+	}
+
+	return q.String(), nil
+}
+
+// List enumerates the users.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToUserListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return UserPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a user, given its ID.
+func Get(client *gophercloud.ServiceClient, userID string) (r GetResult) {
+	_, r.Err = client.Get(userURL(client, userID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToUserCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the attributes of a new user.
+type CreateOpts struct {
+	// Name is the name of the user. It must be unique within its domain.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain of the user. It defaults to the domain
+	// of the client's token.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// DefaultProjectID is the ID of the project the user's tokens are scoped
+	// to when no scope is requested.
+	DefaultProjectID string `json:"default_project_id,omitempty"`
+
+	// Description is a description of the user.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets whether the user is enabled. It defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Password is the password of the user.
+	Password string `json:"password,omitempty"`
+
+	// Options sets the options of the user.
+	Options *Options `json:"options,omitempty"`
+}
+
+// ToUserCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToUserCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "user")
+}
+
+// Create creates a new user.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToUserCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToUserUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a user to update.
+type UpdateOpts struct {
+	// Name is the new name of the user.
+	Name string `json:"name,omitempty"`
+
+	// DefaultProjectID is the ID of the new default project of the user.
+	DefaultProjectID string `json:"default_project_id,omitempty"`
+
+	// Description is the new description of the user. Set it to a pointer to
+	// an empty string to clear it.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled sets whether the user is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Password is the new password of the user. Users changing their own
+	// password should use ChangePassword instead.
+	Password string `json:"password,omitempty"`
+
+	// Options sets the given options of the user. Options that are not set
+	// are left unchanged.
+	Options *Options `json:"options,omitempty"`
+}
+
+// ToUserUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToUserUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "user")
+}
+
+// Update modifies the attributes of a user.
+func Update(client *gophercloud.ServiceClient, userID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToUserUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(userURL(client, userID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a user.
+func Delete(client *gophercloud.ServiceClient, userID string) (r DeleteResult) {
+	_, r.Err = client.Delete(userURL(client, userID), nil)
+	return
+}
+
+// IDFromName is a convenience function that returns a user's ID given its
+// name. User names are only unique within a domain, so the domain of the user
+// should be given by domainID. If domainID is empty, users of every domain the
+// client can see are considered.
+func IDFromName(client *gophercloud.ServiceClient, name, domainID string) (string, error) {
+	return utils.IDFromName(name, utils.FindOpts{
+		ResourceType: "user",
+		Key:          "users",
+		List: func(name string) pagination.Pager {
+			return List(client, ListOpts{Name: name, DomainID: domainID})
+		},
+	})
+}
+
+// ChangePasswordOptsBuilder allows extensions to add additional parameters to
+// the ChangePassword request.
+type ChangePasswordOptsBuilder interface {
+	ToUserChangePasswordMap() (map[string]interface{}, error)
+}
+
+// ChangePasswordOpts specifies the passwords of a ChangePassword request.
+type ChangePasswordOpts struct {
+	// OriginalPassword is the current password of the user.
+	OriginalPassword string `json:"original_password" required:"true"`
+
+	// Password is the new password of the user.
+	Password string `json:"password" required:"true"`
+}
+
+// ToUserChangePasswordMap formats a ChangePasswordOpts into a request.
+func (opts ChangePasswordOpts) ToUserChangePasswordMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "user")
+}
+
+// ChangePassword changes the password of a user. Unlike Update, it requires
+// the current password of the user rather than administrative privileges,
+// and also works when the password has expired. Existing tokens of the user
+// are revoked.
+func ChangePassword(client *gophercloud.ServiceClient, userID string, opts ChangePasswordOptsBuilder) (r ChangePasswordResult) {
+	b, err := opts.ToUserChangePasswordMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(changePasswordURL(client, userID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListGroups enumerates the groups a user is a member of.
+func ListGroups(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	return pagination.NewPager(client, listGroupsURL(client, userID), func(r pagination.PageResult) pagination.Page {
+		return groups.GroupPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListProjects enumerates the projects a user has a role assignment on.
+func ListProjects(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	return pagination.NewPager(client, listProjectsURL(client, userID), func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListInGroup enumerates the users that are members of a group. The filters
+// of opts are applied to the members.
+func ListInGroup(client *gophercloud.ServiceClient, groupID string, opts ListOptsBuilder) pagination.Pager {
+	url := listInGroupURL(client, groupID)
+	if opts != nil {
+		query, err := opts.ToUserListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return UserPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AddToGroup adds a user to a group.
+func AddToGroup(client *gophercloud.ServiceClient, groupID, userID string) (r AddToGroupResult) {
+	_, r.Err = client.Put(membershipURL(client, groupID, userID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// IsMemberOfGroup reports whether a user is a member of a group.
+func IsMemberOfGroup(client *gophercloud.ServiceClient, groupID, userID string) (bool, error) {
+	resp, err := client.Head(membershipURL(client, groupID, userID), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// RemoveFromGroup removes a user from a group.
+func RemoveFromGroup(client *gophercloud.ServiceClient, groupID, userID string) (r RemoveFromGroupResult) {
+	_, r.Err = client.Delete(membershipURL(client, groupID, userID), nil)
+	return
+}
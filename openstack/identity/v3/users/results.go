@@ -0,0 +1,175 @@
+package users
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Options are the security compliance options of a user. Options that are
+// not set on the user are nil.
+type Options struct {
+	// IgnoreChangePasswordUponFirstUse exempts the user from changing their
+	// password after it was set by an administrator.
+	IgnoreChangePasswordUponFirstUse *bool `json:"ignore_change_password_upon_first_use,omitempty"`
+
+	// IgnorePasswordExpiry exempts the password of the user from expiring.
+	IgnorePasswordExpiry *bool `json:"ignore_password_expiry,omitempty"`
+
+	// IgnoreLockoutFailureAttempts exempts the user from being locked out
+	// after repeated failed authentication attempts.
+	IgnoreLockoutFailureAttempts *bool `json:"ignore_lockout_failure_attempts,omitempty"`
+
+	// IgnoreUserInactivity exempts the user from being disabled after a
+	// period of inactivity.
+	IgnoreUserInactivity *bool `json:"ignore_user_inactivity,omitempty"`
+
+	// LockPassword prevents the user from changing their own password.
+	LockPassword *bool `json:"lock_password,omitempty"`
+
+	// MultiFactorAuthEnabled enforces MultiFactorAuthRules for the user.
+	MultiFactorAuthEnabled *bool `json:"multi_factor_auth_enabled,omitempty"`
+
+	// MultiFactorAuthRules lists the combinations of authentication methods
+	// the user may authenticate with, e.g. [["password", "totp"]].
+	MultiFactorAuthRules [][]string `json:"multi_factor_auth_rules,omitempty"`
+}
+
+// User represents an OpenStack Identity user.
+type User struct {
+	// ID is the ID of the user.
+	ID string `json:"id"`
+
+	// Name is the name of the user.
+	Name string `json:"name"`
+
+	// DomainID is the ID of the domain the user belongs to.
+	DomainID string `json:"domain_id"`
+
+	// DefaultProjectID is the ID of the default project of the user.
+	DefaultProjectID string `json:"default_project_id"`
+
+	// Description is the description of the user.
+	Description string `json:"description"`
+
+	// Enabled indicates whether the user is enabled.
+	Enabled bool `json:"enabled"`
+
+	// PasswordExpiresAt is the time at which the password of the user
+	// expires. It is the zero time if the password does not expire.
+	PasswordExpiresAt time.Time `json:"-"`
+
+	// Options are the security compliance options of the user.
+	Options Options `json:"options"`
+
+	// Links contains referencing links to the user.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (u *User) UnmarshalJSON(b []byte) error {
+	type tmp User
+	var s struct {
+		tmp
+		PasswordExpiresAt string `json:"password_expires_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*u = User(s.tmp)
+
+	if s.PasswordExpiresAt != "" {
+		u.PasswordExpiresAt, err = time.Parse(gophercloud.RFC3339MilliNoZ, s.PasswordExpiresAt)
+	}
+	return err
+}
+
+type userResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a User.
+func (r userResult) Extract() (*User, error) {
+	var s struct {
+		User *User `json:"user"`
+	}
+	err := r.ExtractInto(&s)
+	return s.User, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a User.
+type GetResult struct {
+	userResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a User.
+type CreateResult struct {
+	userResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a User.
+type UpdateResult struct {
+	userResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ChangePasswordResult is the response from a ChangePassword operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ChangePasswordResult struct {
+	gophercloud.ErrResult
+}
+
+// AddToGroupResult is the response from an AddToGroup operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type AddToGroupResult struct {
+	gophercloud.ErrResult
+}
+
+// RemoveFromGroupResult is the response from a RemoveFromGroup operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type RemoveFromGroupResult struct {
+	gophercloud.ErrResult
+}
+
+// UserPage is a single page of User results.
+type UserPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a UserPage contains any results.
+func (r UserPage) IsEmpty() (bool, error) {
+	users, err := ExtractUsers(r)
+	return len(users) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r UserPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractUsers returns a slice of Users contained in a single page of
+// results.
+func ExtractUsers(r pagination.Page) ([]User, error) {
+	var s struct {
+		Users []User `json:"users"`
+	}
+	err := (r.(UserPage)).ExtractInto(&s)
+	return s.Users, err
+}
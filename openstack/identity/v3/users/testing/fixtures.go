@@ -0,0 +1,470 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of User results.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null,
+		"self": "http://example.com/identity/v3/users"
+	},
+	"users": [
+		{
+			"domain_id": "default",
+			"enabled": true,
+			"id": "2844b2a08be147a08ef58317d6471f1f",
+			"links": {
+				"self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f"
+			},
+			"name": "glance",
+			"options": {},
+			"password_expires_at": null
+		},
+		{
+			"default_project_id": "263fd9",
+			"description": "James Doe",
+			"domain_id": "1789d1",
+			"enabled": true,
+			"id": "9fe1d3",
+			"links": {
+				"self": "http://example.com/identity/v3/users/9fe1d3"
+			},
+			"name": "jsmith",
+			"options": {
+				"ignore_password_expiry": true,
+				"multi_factor_auth_enabled": true,
+				"multi_factor_auth_rules": [
+					["password", "totp"],
+					["password", "custom-auth-method"]
+				]
+			},
+			"password_expires_at": "2016-11-06T15:32:17.000000"
+		}
+	]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+	"user": {
+		"default_project_id": "263fd9",
+		"description": "James Doe",
+		"domain_id": "1789d1",
+		"enabled": true,
+		"id": "9fe1d3",
+		"links": {
+			"self": "http://example.com/identity/v3/users/9fe1d3"
+		},
+		"name": "jsmith",
+		"options": {
+			"ignore_password_expiry": true,
+			"multi_factor_auth_enabled": true,
+			"multi_factor_auth_rules": [
+				["password", "totp"],
+				["password", "custom-auth-method"]
+			]
+		},
+		"password_expires_at": "2016-11-06T15:32:17.000000"
+	}
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+	"user": {
+		"default_project_id": "263fd9",
+		"description": "James Doe",
+		"domain_id": "1789d1",
+		"name": "jsmith",
+		"password": "secretsecret",
+		"options": {
+			"ignore_password_expiry": true,
+			"multi_factor_auth_enabled": true,
+			"multi_factor_auth_rules": [
+				["password", "totp"],
+				["password", "custom-auth-method"]
+			]
+		}
+	}
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+	"user": {
+		"enabled": false,
+		"options": {
+			"ignore_lockout_failure_attempts": true
+		}
+	}
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+	"user": {
+		"default_project_id": "263fd9",
+		"description": "James Doe",
+		"domain_id": "1789d1",
+		"enabled": false,
+		"id": "9fe1d3",
+		"links": {
+			"self": "http://example.com/identity/v3/users/9fe1d3"
+		},
+		"name": "jsmith",
+		"options": {
+			"ignore_lockout_failure_attempts": true,
+			"ignore_password_expiry": true,
+			"multi_factor_auth_enabled": true,
+			"multi_factor_auth_rules": [
+				["password", "totp"],
+				["password", "custom-auth-method"]
+			]
+		},
+		"password_expires_at": "2016-11-06T15:32:17.000000"
+	}
+}
+`
+
+// ChangePasswordRequest provides the input to a ChangePassword request.
+const ChangePasswordRequest = `
+{
+	"user": {
+		"password": "new_secretsecret",
+		"original_password": "secretsecret"
+	}
+}
+`
+
+// ListGroupsOutput provides a single page of the groups of a user.
+const ListGroupsOutput = `
+{
+	"groups": [
+		{
+			"description": "Developers cleared for work on all general projects",
+			"domain_id": "1789d1",
+			"id": "ea167b",
+			"links": {
+				"self": "https://example.com/identity/v3/groups/ea167b"
+			},
+			"name": "Developers"
+		}
+	],
+	"links": {
+		"self": "http://example.com/identity/v3/users/9fe1d3/groups",
+		"previous": null,
+		"next": null
+	}
+}
+`
+
+// ListProjectsOutput provides a single page of the projects of a user.
+const ListProjectsOutput = `
+{
+	"links": {
+		"self": "http://example.com/identity/v3/users/9fe1d3/projects",
+		"previous": null,
+		"next": null
+	},
+	"projects": [
+		{
+			"description": "my first project",
+			"domain_id": "1789d1",
+			"enabled": true,
+			"id": "263fd9",
+			"is_domain": false,
+			"links": {
+				"self": "https://example.com/identity/v3/projects/263fd9"
+			},
+			"name": "Test Group",
+			"parent_id": "1789d1",
+			"tags": []
+		}
+	]
+}
+`
+
+var (
+	yes = true
+	no  = false
+)
+
+// FirstUser is the first user in the List request.
+var FirstUser = users.User{
+	DomainID: "default",
+	Enabled:  true,
+	ID:       "2844b2a08be147a08ef58317d6471f1f",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f",
+	},
+	Name: "glance",
+}
+
+// SecondUser is the second user in the List request.
+var SecondUser = users.User{
+	DefaultProjectID: "263fd9",
+	Description:      "James Doe",
+	DomainID:         "1789d1",
+	Enabled:          true,
+	ID:               "9fe1d3",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/users/9fe1d3",
+	},
+	Name: "jsmith",
+	Options: users.Options{
+		IgnorePasswordExpiry:   &yes,
+		MultiFactorAuthEnabled: &yes,
+		MultiFactorAuthRules: [][]string{
+			{"password", "totp"},
+			{"password", "custom-auth-method"},
+		},
+	},
+	PasswordExpiresAt: time.Date(2016, 11, 6, 15, 32, 17, 0, time.UTC),
+}
+
+// SecondUserUpdated is how SecondUser should look after an Update.
+var SecondUserUpdated = users.User{
+	DefaultProjectID: "263fd9",
+	Description:      "James Doe",
+	DomainID:         "1789d1",
+	Enabled:          false,
+	ID:               "9fe1d3",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/users/9fe1d3",
+	},
+	Name: "jsmith",
+	Options: users.Options{
+		IgnoreLockoutFailureAttempts: &yes,
+		IgnorePasswordExpiry:         &yes,
+		MultiFactorAuthEnabled:       &yes,
+		MultiFactorAuthRules: [][]string{
+			{"password", "totp"},
+			{"password", "custom-auth-method"},
+		},
+	},
+	PasswordExpiresAt: time.Date(2016, 11, 6, 15, 32, 17, 0, time.UTC),
+}
+
+// ExpectedUsersSlice is the slice of users expected to be returned from
+// ListOutput.
+var ExpectedUsersSlice = []users.User{FirstUser, SecondUser}
+
+// ExpectedGroupsSlice is the slice of groups expected to be returned from
+// ListGroupsOutput.
+var ExpectedGroupsSlice = []groups.Group{
+	{
+		Description: "Developers cleared for work on all general projects",
+		DomainID:    "1789d1",
+		ID:          "ea167b",
+		Links: map[string]interface{}{
+			"self": "https://example.com/identity/v3/groups/ea167b",
+		},
+		Name: "Developers",
+	},
+}
+
+// ExpectedProjectsSlice is the slice of projects expected to be returned
+// from ListProjectsOutput.
+var ExpectedProjectsSlice = []projects.Project{
+	{
+		Description: "my first project",
+		DomainID:    "1789d1",
+		Enabled:     true,
+		ID:          "263fd9",
+		Links: map[string]interface{}{
+			"self": "https://example.com/identity/v3/projects/263fd9",
+		},
+		Name:     "Test Group",
+		ParentID: "1789d1",
+		Tags:     []string{},
+	},
+}
+
+// HandleListUsersSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that responds with a list of two users.
+func HandleListUsersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"enabled":             "true",
+			"password_expires_at": "lt:2027-01-01T00:00:00Z",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetUserSuccessfully creates an HTTP handler at `/users/9fe1d3` on
+// the test handler mux that responds with a single user.
+func HandleGetUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateUserSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that tests user creation.
+func HandleCreateUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleUpdateUserSuccessfully creates an HTTP handler at `/users/9fe1d3` on
+// the test handler mux that tests user updates.
+func HandleUpdateUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteUserSuccessfully creates an HTTP handler at `/users/9fe1d3` on
+// the test handler mux that tests user deletion.
+func HandleDeleteUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleChangeUserPasswordSuccessfully creates an HTTP handler at
+// `/users/9fe1d3/password` on the test handler mux that tests password
+// changes.
+func HandleChangeUserPasswordSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3/password", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ChangePasswordRequest)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListUserGroupsSuccessfully creates an HTTP handler at
+// `/users/9fe1d3/groups` on the test handler mux that responds with a list
+// of one group.
+func HandleListUserGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListGroupsOutput)
+	})
+}
+
+// HandleListUserProjectsSuccessfully creates an HTTP handler at
+// `/users/9fe1d3/projects` on the test handler mux that responds with a list
+// of one project.
+func HandleListUserProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListProjectsOutput)
+	})
+}
+
+// HandleListInGroupSuccessfully creates an HTTP handler at
+// `/groups/ea167b/users` on the test handler mux that responds with a list
+// of two users.
+func HandleListInGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/ea167b/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGroupMembershipSuccessfully creates an HTTP handler at
+// `/groups/ea167b/users/9fe1d3` on the test handler mux that adds, checks
+// and removes the membership of the user in the group. The user is a member
+// of no other group.
+func HandleGroupMembershipSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/ea167b/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT", "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/groups/1c92f3/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+// HandleFindUserSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that responds with the users matching the name and
+// domain filters.
+func HandleFindUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":      "jsmith",
+			"domain_id": "1789d1",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"users": [{"id": "9fe1d3", "name": "jsmith", "domain_id": "1789d1"}], "links": {"next": null}}`)
+	})
+}
@@ -0,0 +1,190 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListUsers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUsersSuccessfully(t)
+
+	listOpts := users.ListOpts{
+		Enabled:           &yes,
+		PasswordExpiresAt: "lt:2027-01-01T00:00:00Z",
+	}
+
+	count := 0
+	err := users.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := users.ExtractUsers(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedUsersSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetUserSuccessfully(t)
+
+	actual, err := users.Get(client.ServiceClient(), "9fe1d3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondUser, *actual)
+	th.CheckEquals(t, true, *actual.Options.IgnorePasswordExpiry)
+}
+
+func TestCreateUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateUserSuccessfully(t)
+
+	createOpts := users.CreateOpts{
+		Name:             "jsmith",
+		DomainID:         "1789d1",
+		DefaultProjectID: "263fd9",
+		Description:      "James Doe",
+		Password:         "secretsecret",
+		Options: &users.Options{
+			IgnorePasswordExpiry:   &yes,
+			MultiFactorAuthEnabled: &yes,
+			MultiFactorAuthRules: [][]string{
+				{"password", "totp"},
+				{"password", "custom-auth-method"},
+			},
+		},
+	}
+
+	actual, err := users.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondUser, *actual)
+}
+
+func TestUpdateUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateUserSuccessfully(t)
+
+	updateOpts := users.UpdateOpts{
+		Enabled: &no,
+		Options: &users.Options{
+			IgnoreLockoutFailureAttempts: &yes,
+		},
+	}
+
+	actual, err := users.Update(client.ServiceClient(), "9fe1d3", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondUserUpdated, *actual)
+}
+
+func TestDeleteUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteUserSuccessfully(t)
+
+	res := users.Delete(client.ServiceClient(), "9fe1d3")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestChangeUserPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChangeUserPasswordSuccessfully(t)
+
+	changePasswordOpts := users.ChangePasswordOpts{
+		OriginalPassword: "secretsecret",
+		Password:         "new_secretsecret",
+	}
+
+	res := users.ChangePassword(client.ServiceClient(), "9fe1d3", changePasswordOpts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestChangeUserPasswordRequiresOriginal(t *testing.T) {
+	res := users.ChangePassword(client.ServiceClient(), "9fe1d3", users.ChangePasswordOpts{Password: "new_secretsecret"})
+	if res.Err == nil {
+		t.Fatal("expected error but call succeeded")
+	}
+}
+
+func TestListUserGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUserGroupsSuccessfully(t)
+
+	allPages, err := users.ListGroups(client.ServiceClient(), "9fe1d3").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := groups.ExtractGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedGroupsSlice, actual)
+}
+
+func TestListUserProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUserProjectsSuccessfully(t)
+
+	allPages, err := users.ListProjects(client.ServiceClient(), "9fe1d3").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectsSlice, actual)
+}
+
+func TestListInGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListInGroupSuccessfully(t)
+
+	allPages, err := users.ListInGroup(client.ServiceClient(), "ea167b", users.ListOpts{Enabled: &yes}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := users.ExtractUsers(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedUsersSlice, actual)
+}
+
+func TestGroupMembership(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupMembershipSuccessfully(t)
+
+	err := users.AddToGroup(client.ServiceClient(), "ea167b", "9fe1d3").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := users.IsMemberOfGroup(client.ServiceClient(), "ea167b", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	ok, err = users.IsMemberOfGroup(client.ServiceClient(), "1c92f3", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, ok)
+
+	err = users.RemoveFromGroup(client.ServiceClient(), "ea167b", "9fe1d3").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFindUserSuccessfully(t)
+
+	id, err := users.IDFromName(client.ServiceClient(), "jsmith", "1789d1")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "9fe1d3", id)
+}
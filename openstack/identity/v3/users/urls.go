@@ -0,0 +1,31 @@
+package users
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("users")
+}
+
+func userURL(client *gophercloud.ServiceClient, userID string) string {
+	return client.ServiceURL("users", userID)
+}
+
+func changePasswordURL(client *gophercloud.ServiceClient, userID string) string {
+	return client.ServiceURL("users", userID, "password")
+}
+
+func listGroupsURL(client *gophercloud.ServiceClient, userID string) string {
+	return client.ServiceURL("users", userID, "groups")
+}
+
+func listProjectsURL(client *gophercloud.ServiceClient, userID string) string {
+	return client.ServiceURL("users", userID, "projects")
+}
+
+func listInGroupURL(client *gophercloud.ServiceClient, groupID string) string {
+	return client.ServiceURL("groups", groupID, "users")
+}
+
+func membershipURL(client *gophercloud.ServiceClient, groupID, userID string) string {
+	return client.ServiceURL("groups", groupID, "users", userID)
+}
@@ -37,6 +37,20 @@ type LoadBalancer struct {
 	Listeners []listeners.Listener `json:"listeners"`
 }
 
+// State implements gophercloud.StatefulResource, based on the provisioning
+// status of the load balancer.
+func (r LoadBalancer) State() (gophercloud.ResourceState, string) {
+	switch r.ProvisioningStatus {
+	case "ACTIVE":
+		return gophercloud.StateReady, r.ProvisioningStatus
+	case "DELETED":
+		return gophercloud.StateDeleted, r.ProvisioningStatus
+	case "ERROR":
+		return gophercloud.StateFailed, r.ProvisioningStatus
+	}
+	return gophercloud.StatePending, r.ProvisioningStatus
+}
+
 type StatusTree struct {
 	Loadbalancer *LoadBalancer `json:"loadbalancer"`
 }
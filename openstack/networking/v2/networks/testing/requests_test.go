@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -275,3 +276,72 @@ func TestDelete(t *testing.T) {
 	res := networks.Delete(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch r.URL.Query().Get("name") {
+		case "private-network":
+			fmt.Fprintf(w, `{"networks": [{"name": "private-network", "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"}]}`)
+		case "public-network":
+			fmt.Fprintf(w, `{"networks": [{"name": "public-network", "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324"}, {"name": "public-network", "id": "bc2b4f2d-e8c2-4b3a-9a15-8b62d1bd2a3c"}]}`)
+		default:
+			fmt.Fprintf(w, `{"networks": []}`)
+		}
+	})
+
+	id, err := networks.IDFromName(fake.ServiceClient(), "private-network")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", id)
+
+	_, err = networks.IDFromName(fake.ServiceClient(), "missing-network")
+	_, ok := err.(gophercloud.ErrResourceNotFound)
+	th.AssertEquals(t, true, ok)
+
+	_, err = networks.IDFromName(fake.ServiceClient(), "public-network")
+	multiple, ok := err.(gophercloud.ErrMultipleResourcesFound)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 2, multiple.Count)
+	th.AssertDeepEquals(t, []string{"db193ab3-96e3-4cb3-8fc5-05f4296d0324", "bc2b4f2d-e8c2-4b3a-9a15-8b62d1bd2a3c"}, multiple.IDs)
+}
+
+func TestFindID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"network": {"name": "private-network", "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"}}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/networks/private-network", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"name": "private-network"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"networks": [{"name": "private-network", "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"}]}`)
+	})
+
+	id, err := networks.FindID(fake.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", id)
+
+	id, err = networks.FindID(fake.ServiceClient(), "private-network")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", id)
+}
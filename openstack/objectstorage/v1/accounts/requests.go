@@ -35,7 +35,7 @@ func Get(c *gophercloud.ServiceClient, opts GetOptsBuilder) (r GetResult) {
 			h[k] = v
 		}
 	}
-	resp, err := c.Request("HEAD", getURL(c), &gophercloud.RequestOpts{
+	resp, err := c.Head(getURL(c), &gophercloud.RequestOpts{
 		MoreHeaders: h,
 		OkCodes:     []int{204},
 	})
@@ -88,7 +88,7 @@ func Update(c *gophercloud.ServiceClient, opts UpdateOptsBuilder) (r UpdateResul
 			h[k] = v
 		}
 	}
-	resp, err := c.Request("POST", updateURL(c), &gophercloud.RequestOpts{
+	resp, err := c.Post(updateURL(c), nil, nil, &gophercloud.RequestOpts{
 		MoreHeaders: h,
 		OkCodes:     []int{201, 202, 204},
 	})
@@ -339,7 +339,7 @@ func Get(c *gophercloud.ServiceClient, containerName, objectName string, opts Ge
 		}
 		url += query
 	}
-	resp, err := c.Request("HEAD", url, &gophercloud.RequestOpts{
+	resp, err := c.Head(url, &gophercloud.RequestOpts{
 		OkCodes: []int{200, 204},
 	})
 	if resp != nil {
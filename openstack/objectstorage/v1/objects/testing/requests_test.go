@@ -3,9 +3,11 @@ package testing
 import (
 	"bytes"
 	"io"
+	"net/http"
 	"strings"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -180,3 +182,47 @@ func TestGetObject(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, expected, actual)
 }
+
+type fakeReporter struct {
+	metrics []gophercloud.RequestMetrics
+}
+
+func (r *fakeReporter) ReportRequest(m gophercloud.RequestMetrics) {
+	r.metrics = append(r.metrics, m)
+}
+
+func TestRequestMetrics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v1/AUTH_test/logs/2016/10/app.log", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusCreated)
+		case "HEAD":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	})
+
+	reporter := new(fakeReporter)
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+	client.Type = "object-store"
+	client.Metrics = reporter
+
+	content := strings.NewReader("did gyre and gimble in the wabe")
+	res := objects.Create(client, "logs", "2016/10/app.log", objects.CreateOpts{Content: content})
+	th.AssertNoErr(t, res.Err)
+	_, err := objects.Get(client, "logs", "2016/10/app.log", nil).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(reporter.metrics))
+	for i, method := range []string{"PUT", "HEAD"} {
+		m := reporter.metrics[i]
+		th.CheckEquals(t, "object-store", m.ServiceType)
+		th.CheckEquals(t, method, m.Method)
+		th.CheckEquals(t, "/v1/{id}/{id}/{id}", m.URLTemplate)
+	}
+}
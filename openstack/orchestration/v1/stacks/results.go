@@ -2,6 +2,7 @@ package stacks
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -58,6 +59,11 @@ type ListedStack struct {
 	UpdatedTime  gophercloud.JSONRFC3339NoZ `json:"updated_time"`
 }
 
+// State implements gophercloud.StatefulResource.
+func (r ListedStack) State() (gophercloud.ResourceState, string) {
+	return stackState(r.Status), r.Status
+}
+
 // ExtractStacks extracts and returns a slice of ListedStack. It is used while iterating
 // over a stacks.List call.
 func ExtractStacks(r pagination.Page) ([]ListedStack, error) {
@@ -88,6 +94,28 @@ type RetrievedStack struct {
 	UpdatedTime         gophercloud.JSONRFC3339NoZ `json:"updated_time"`
 }
 
+// State implements gophercloud.StatefulResource.
+func (r RetrievedStack) State() (gophercloud.ResourceState, string) {
+	return stackState(r.Status), r.Status
+}
+
+// stackState maps a stack status, made of an action and its outcome (e.g.
+// CREATE_COMPLETE), to a gophercloud.ResourceState. A stack that was rolled
+// back after a failed create or update is considered failed.
+func stackState(status string) gophercloud.ResourceState {
+	switch {
+	case status == "DELETE_COMPLETE":
+		return gophercloud.StateDeleted
+	case status == "DELETE_FAILED":
+		return gophercloud.StateDeleteFailed
+	case status == "ROLLBACK_COMPLETE", strings.HasSuffix(status, "_FAILED"):
+		return gophercloud.StateFailed
+	case strings.HasSuffix(status, "_COMPLETE"):
+		return gophercloud.StateReady
+	}
+	return gophercloud.StatePending
+}
+
 // GetResult represents the result of a Get operation.
 type GetResult struct {
 	gophercloud.Result
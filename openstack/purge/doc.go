@@ -0,0 +1,40 @@
+/*
+Package purge deletes the resources a project owns across the compute,
+networking, block storage, object storage and orchestration services, in
+dependency order. It is mainly intended to tear down test projects.
+
+Resources are discovered with the list APIs of each service, so the service
+clients must be authenticated with a token scoped to the project: Discover
+and Purge return an ErrProjectMismatch when the token of a client is scoped
+to another project than Opts.ProjectID. Networking resources are additionally
+filtered by ProjectID, since administrators can list the resources of every
+project.
+
+Resources are deleted in the following order, waiting for asynchronous
+deletions to complete where a later step depends on them: stacks, servers,
+floating IPs, router interfaces, routers, ports, subnets, networks, security
+groups, snapshots, volumes, objects and containers.
+
+Example to List the Resources that would be Purged
+
+	clients := purge.Clients{
+		Compute:       computeClient,
+		Network:       networkClient,
+		BlockStorage:  blockStorageClient,
+		ObjectStorage: objectStorageClient,
+	}
+
+	resources, err := purge.Purge(clients, purge.Opts{
+		ProjectID:  "9fe1d3b6d7a44a5f8e6ac1a3b9b8d4a7",
+		NamePrefix: "ci-",
+		DryRun:     true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range resources {
+		fmt.Printf("%s %s (%s)\n", r.Type, r.Name, r.ID)
+	}
+*/
+package purge
@@ -0,0 +1,23 @@
+package purge
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrProjectMismatch is the error returned by Discover and Purge when a
+// service client is authenticated with a token that is not scoped to
+// Opts.ProjectID. Only networking resources can be filtered by project, so
+// the other services would list the resources of another project.
+type ErrProjectMismatch struct {
+	gophercloud.ErrInvalidInput
+
+	// TokenProjectID is the ID of the project the token is scoped to. It is
+	// empty for tokens that are not scoped to a project.
+	TokenProjectID string
+}
+
+func (e ErrProjectMismatch) Error() string {
+	return fmt.Sprintf("Cannot purge project [%v] with a token scoped to project [%s]", e.Value, e.TokenProjectID)
+}
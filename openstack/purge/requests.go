@@ -0,0 +1,559 @@
+package purge
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/bulk"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v1/snapshots"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// DefaultTimeout is the number of seconds Purge waits for an asynchronous
+// deletion to complete when Opts.Timeout is not set.
+const DefaultTimeout = 600
+
+// Clients holds the service clients used to discover and delete resources. A
+// nil client skips the resources of that service.
+type Clients struct {
+	// Compute is a compute v2 client, used for servers.
+	Compute *gophercloud.ServiceClient
+
+	// Network is a networking v2 client, used for floating IPs, routers,
+	// ports, subnets, networks and security groups.
+	Network *gophercloud.ServiceClient
+
+	// BlockStorage is a block storage v2 client, used for volumes.
+	BlockStorage *gophercloud.ServiceClient
+
+	// BlockStorageV1 is a block storage v1 client, used for snapshots.
+	// Volumes that have snapshots cannot be deleted without it.
+	BlockStorageV1 *gophercloud.ServiceClient
+
+	// ObjectStorage is an object storage v1 client, used for objects and
+	// containers.
+	ObjectStorage *gophercloud.ServiceClient
+
+	// Orchestration is an orchestration v1 client, used for stacks.
+	Orchestration *gophercloud.ServiceClient
+}
+
+// Opts configures Discover and Purge.
+type Opts struct {
+	// ProjectID is the ID of the project being purged. It is required when a
+	// Network client is provided. Clients authenticated with a token scoped
+	// to another project are refused.
+	ProjectID string
+
+	// NamePrefix, if set, limits the purge to resources whose name starts
+	// with it.
+	NamePrefix string
+
+	// CreatedBefore, if set, limits the purge to resources created before it.
+	// Resources that do not report a creation time (networking resources and
+	// containers) never match.
+	CreatedBefore time.Time
+
+	// DryRun makes Purge return the resources it would delete without
+	// deleting them.
+	DryRun bool
+
+	// Concurrency is the number of resources deleted at once. It defaults to
+	// bulk.DefaultConcurrency.
+	Concurrency int
+
+	// Timeout is the number of seconds to wait for each stack, server and
+	// snapshot to be deleted, and for each volume to be detached. It defaults
+	// to DefaultTimeout.
+	Timeout int
+}
+
+// filtered reports whether a name or creation time filter is set.
+func (opts Opts) filtered() bool {
+	return opts.NamePrefix != "" || !opts.CreatedBefore.IsZero()
+}
+
+// matches reports whether a resource passes the name and creation time
+// filters. created is nil for resources that do not report a creation time.
+func (opts Opts) matches(name string, created *time.Time) bool {
+	if opts.NamePrefix != "" && !strings.HasPrefix(name, opts.NamePrefix) {
+		return false
+	}
+	if !opts.CreatedBefore.IsZero() && (created == nil || !created.Before(opts.CreatedBefore)) {
+		return false
+	}
+	return true
+}
+
+// Discover returns the resources Purge would delete, in deletion order.
+//
+// Besides the resources that match the filters of opts, it includes the
+// resources that must go for them to be deleted: the subnets, ports and router
+// interfaces of purged networks and subnets, the ports of purged servers, the
+// floating IPs of purged ports, the snapshots of purged volumes and the
+// objects of purged containers.
+func Discover(clients Clients, opts Opts) ([]Resource, error) {
+	if clients.Network != nil && opts.ProjectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "purge.Opts.ProjectID"
+		return nil, err
+	}
+	if err := checkProject(clients, opts.ProjectID); err != nil {
+		return nil, err
+	}
+
+	d := &discovery{clients: clients, opts: opts, found: make(map[ResourceType][]Resource)}
+	for _, discover := range []func() error{
+		d.stacks,
+		d.servers,
+		d.network,
+		d.volumes,
+		d.objects,
+	} {
+		if err := discover(); err != nil {
+			return nil, err
+		}
+	}
+
+	var resources []Resource
+	for _, t := range deletionOrder {
+		resources = append(resources, d.found[t]...)
+	}
+	return resources, nil
+}
+
+// checkProject returns an ErrProjectMismatch if one of clients is
+// authenticated with a token that is not scoped to projectID. Clients whose
+// identity is unknown, such as those given a TokenID directly, are trusted.
+func checkProject(clients Clients, projectID string) error {
+	if projectID == "" {
+		return nil
+	}
+	for _, c := range []*gophercloud.ServiceClient{
+		clients.Compute,
+		clients.Network,
+		clients.BlockStorage,
+		clients.BlockStorageV1,
+		clients.ObjectStorage,
+		clients.Orchestration,
+	} {
+		if c == nil || c.ProviderClient == nil || c.AuthIdentity == nil {
+			continue
+		}
+		if c.AuthIdentity.ProjectID != projectID {
+			err := ErrProjectMismatch{TokenProjectID: c.AuthIdentity.ProjectID}
+			err.Argument = "purge.Opts.ProjectID"
+			err.Value = projectID
+			return err
+		}
+	}
+	return nil
+}
+
+// Purge deletes the resources returned by Discover, in order, and returns
+// them. If deleting a resource fails, Purge stops after the current resource
+// type and returns a *bulk.ErrOperationsFailed.
+func Purge(clients Clients, opts Opts) ([]Resource, error) {
+	resources, err := Discover(clients, opts)
+	if err != nil || opts.DryRun {
+		return resources, err
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	for _, t := range deletionOrder {
+		var ops []bulk.Operation
+		for _, r := range resources {
+			if r.Type == t {
+				r := r
+				ops = append(ops, func() (interface{}, error) {
+					return nil, deleteResource(clients, r, timeout)
+				})
+			}
+		}
+		if _, err := bulk.Execute(ops, bulk.Opts{Concurrency: opts.Concurrency}); err != nil {
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+var deletionOrder = []ResourceType{
+	TypeStack,
+	TypeServer,
+	TypeFloatingIP,
+	TypeRouterInterface,
+	TypeRouter,
+	TypePort,
+	TypeSubnet,
+	TypeNetwork,
+	TypeSecurityGroup,
+	TypeSnapshot,
+	TypeVolume,
+	TypeObject,
+	TypeContainer,
+}
+
+// deleteResource deletes a single resource and, for stacks, servers and
+// snapshots, waits for it to be gone. Volumes are retried until they are
+// detached. Resources that no longer exist are considered deleted.
+func deleteResource(clients Clients, r Resource, timeout int) error {
+	var err error
+	var get gophercloud.StateFunc
+
+	switch r.Type {
+	case TypeStack:
+		err = stacks.Delete(clients.Orchestration, r.Name, r.ID).ExtractErr()
+		get = func() (gophercloud.StatefulResource, error) {
+			return stacks.Get(clients.Orchestration, r.Name, r.ID).Extract()
+		}
+	case TypeServer:
+		err = servers.Delete(clients.Compute, r.ID).ExtractErr()
+		get = func() (gophercloud.StatefulResource, error) {
+			return servers.Get(clients.Compute, r.ID).Extract()
+		}
+	case TypeFloatingIP:
+		err = floatingips.Delete(clients.Network, r.ID).ExtractErr()
+	case TypeRouterInterface:
+		_, err = routers.RemoveInterface(clients.Network, r.Parent, routers.RemoveInterfaceOpts{PortID: r.ID}).Extract()
+	case TypeRouter:
+		err = routers.Delete(clients.Network, r.ID).ExtractErr()
+	case TypePort:
+		err = ports.Delete(clients.Network, r.ID).ExtractErr()
+	case TypeSubnet:
+		err = subnets.Delete(clients.Network, r.ID).ExtractErr()
+	case TypeNetwork:
+		err = networks.Delete(clients.Network, r.ID).ExtractErr()
+	case TypeSecurityGroup:
+		err = groups.Delete(clients.Network, r.ID).ExtractErr()
+	case TypeSnapshot:
+		err = snapshots.Delete(clients.BlockStorageV1, r.ID).ExtractErr()
+		get = func() (gophercloud.StatefulResource, error) {
+			return snapshots.Get(clients.BlockStorageV1, r.ID).Extract()
+		}
+	case TypeVolume:
+		err = deleteVolume(clients.BlockStorage, r.ID, timeout)
+	case TypeObject:
+		err = objects.Delete(clients.ObjectStorage, r.Parent, r.ID, nil).Err
+	case TypeContainer:
+		err = containers.Delete(clients.ObjectStorage, r.ID).Err
+	}
+
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return nil
+	}
+	if err != nil || get == nil {
+		return err
+	}
+	return gophercloud.WaitUntilDeleted(timeout, get)
+}
+
+// deleteVolume deletes a volume. Compute detaches the volumes of deleted
+// servers asynchronously, and the block storage service refuses to delete a
+// volume that is still attached with a 400 response, so the deletion is
+// retried until it is accepted or timeout is exceeded, in which case the last
+// refusal is returned.
+func deleteVolume(client *gophercloud.ServiceClient, id string, timeout int) error {
+	err := volumes.Delete(client, id).ExtractErr()
+	if _, ok := err.(gophercloud.ErrDefault400); !ok {
+		return err
+	}
+	gophercloud.WaitFor(timeout, func() (bool, error) {
+		err = volumes.Delete(client, id).ExtractErr()
+		_, inUse := err.(gophercloud.ErrDefault400)
+		return !inUse, nil
+	})
+	return err
+}
+
+// discovery accumulates the resources found by Discover.
+type discovery struct {
+	clients Clients
+	opts    Opts
+	found   map[ResourceType][]Resource
+
+	// purgedServers holds the IDs of the servers being purged.
+	purgedServers map[string]bool
+}
+
+func (d *discovery) add(t ResourceType, id, name, parent string) {
+	d.found[t] = append(d.found[t], Resource{Type: t, ID: id, Name: name, Parent: parent})
+}
+
+func (d *discovery) stacks() error {
+	if d.clients.Orchestration == nil {
+		return nil
+	}
+	return stacks.List(d.clients.Orchestration, nil).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := stacks.ExtractStacks(page)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range all {
+			created := time.Time(s.CreationTime)
+			if d.opts.matches(s.Name, &created) {
+				d.add(TypeStack, s.ID, s.Name, "")
+			}
+		}
+		return true, nil
+	})
+}
+
+func (d *discovery) servers() error {
+	d.purgedServers = make(map[string]bool)
+	if d.clients.Compute == nil {
+		return nil
+	}
+	return servers.List(d.clients.Compute, nil).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := servers.ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range all {
+			var created *time.Time
+			if t, err := time.Parse(time.RFC3339, s.Created); err == nil {
+				created = &t
+			}
+			if d.opts.matches(s.Name, created) {
+				d.purgedServers[s.ID] = true
+				d.add(TypeServer, s.ID, s.Name, "")
+			}
+		}
+		return true, nil
+	})
+}
+
+func (d *discovery) network() error {
+	if d.clients.Network == nil {
+		return nil
+	}
+	c := d.clients.Network
+	project := d.opts.ProjectID
+
+	purgedNetworks := make(map[string]bool)
+	err := networks.List(c, networks.ListOpts{TenantID: project}).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := networks.ExtractNetworks(page)
+		if err != nil {
+			return false, err
+		}
+		for _, n := range all {
+			if d.opts.matches(n.Name, nil) {
+				purgedNetworks[n.ID] = true
+				d.add(TypeNetwork, n.ID, n.Name, "")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	purgedSubnets := make(map[string]bool)
+	err = subnets.List(c, subnets.ListOpts{TenantID: project}).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := subnets.ExtractSubnets(page)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range all {
+			if purgedNetworks[s.NetworkID] || d.opts.matches(s.Name, nil) {
+				purgedSubnets[s.ID] = true
+				d.add(TypeSubnet, s.ID, s.Name, "")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	purgedRouters := make(map[string]bool)
+	err = routers.List(c, routers.ListOpts{TenantID: project}).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := routers.ExtractRouters(page)
+		if err != nil {
+			return false, err
+		}
+		for _, r := range all {
+			if d.opts.matches(r.Name, nil) {
+				purgedRouters[r.ID] = true
+				d.add(TypeRouter, r.ID, r.Name, "")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	purgedPorts := make(map[string]bool)
+	err = ports.List(c, ports.ListOpts{TenantID: project}).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := ports.ExtractPorts(page)
+		if err != nil {
+			return false, err
+		}
+		for _, p := range all {
+			switch {
+			case isRouterInterface(p):
+				if purgedRouters[p.DeviceID] || onSubnet(p, purgedSubnets) {
+					d.add(TypeRouterInterface, p.ID, p.Name, p.DeviceID)
+				}
+			case strings.HasPrefix(p.DeviceOwner, "network:"):
+				// DHCP, gateway and floating IP ports are managed by Neutron.
+			case strings.HasPrefix(p.DeviceOwner, "compute:") && !purgedNetworks[p.NetworkID]:
+				if d.purgedServers[p.DeviceID] {
+					purgedPorts[p.ID] = true
+					d.add(TypePort, p.ID, p.Name, "")
+				}
+			case purgedNetworks[p.NetworkID] || d.opts.matches(p.Name, nil):
+				purgedPorts[p.ID] = true
+				d.add(TypePort, p.ID, p.Name, "")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = floatingips.List(c, floatingips.ListOpts{TenantID: project}).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := floatingips.ExtractFloatingIPs(page)
+		if err != nil {
+			return false, err
+		}
+		for _, ip := range all {
+			if !d.opts.filtered() || purgedPorts[ip.PortID] {
+				d.add(TypeFloatingIP, ip.ID, ip.FloatingIP, "")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return groups.List(c, groups.ListOpts{TenantID: project}).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := groups.ExtractGroups(page)
+		if err != nil {
+			return false, err
+		}
+		for _, g := range all {
+			if g.Name != "default" && d.opts.matches(g.Name, nil) {
+				d.add(TypeSecurityGroup, g.ID, g.Name, "")
+			}
+		}
+		return true, nil
+	})
+}
+
+func isRouterInterface(p ports.Port) bool {
+	switch p.DeviceOwner {
+	case "network:router_interface", "network:router_interface_distributed", "network:ha_router_replicated_interface":
+		return true
+	}
+	return false
+}
+
+func onSubnet(p ports.Port, subnets map[string]bool) bool {
+	for _, ip := range p.FixedIPs {
+		if subnets[ip.SubnetID] {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *discovery) volumes() error {
+	purgedVolumes := make(map[string]bool)
+	if d.clients.BlockStorage != nil {
+		err := volumes.List(d.clients.BlockStorage, nil).EachPage(func(page pagination.Page) (bool, error) {
+			all, err := volumes.ExtractVolumes(page)
+			if err != nil {
+				return false, err
+			}
+			for _, v := range all {
+				created := time.Time(v.CreatedAt)
+				if d.opts.matches(v.Name, &created) {
+					purgedVolumes[v.ID] = true
+					d.add(TypeVolume, v.ID, v.Name, "")
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.clients.BlockStorageV1 == nil {
+		return nil
+	}
+	return snapshots.List(d.clients.BlockStorageV1, nil).EachPage(func(page pagination.Page) (bool, error) {
+		all, err := snapshots.ExtractSnapshots(page)
+		if err != nil {
+			return false, err
+		}
+		for _, s := range all {
+			created := time.Time(s.CreatedAt)
+			if purgedVolumes[s.VolumeID] || d.opts.matches(s.Name, &created) {
+				d.add(TypeSnapshot, s.ID, s.Name, "")
+			}
+		}
+		return true, nil
+	})
+}
+
+func (d *discovery) objects() error {
+	if d.clients.ObjectStorage == nil {
+		return nil
+	}
+	c := d.clients.ObjectStorage
+
+	var purged []string
+	err := containers.List(c, &containers.ListOpts{Full: true}).EachPage(func(page pagination.Page) (bool, error) {
+		names, err := containers.ExtractNames(page)
+		if err != nil {
+			return false, err
+		}
+		for _, name := range names {
+			if d.opts.matches(name, nil) {
+				purged = append(purged, name)
+				d.add(TypeContainer, name, name, "")
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, container := range purged {
+		err := objects.List(c, container, &objects.ListOpts{Full: true}).EachPage(func(page pagination.Page) (bool, error) {
+			names, err := objects.ExtractNames(page)
+			if err != nil {
+				return false, err
+			}
+			for _, name := range names {
+				d.add(TypeObject, name, name, container)
+			}
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
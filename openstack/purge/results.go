@@ -0,0 +1,37 @@
+package purge
+
+// ResourceType identifies the kind of a Resource.
+type ResourceType string
+
+// The resource types handled by Purge, in deletion order.
+const (
+	TypeStack           ResourceType = "stack"
+	TypeServer          ResourceType = "server"
+	TypeFloatingIP      ResourceType = "floating_ip"
+	TypeRouterInterface ResourceType = "router_interface"
+	TypeRouter          ResourceType = "router"
+	TypePort            ResourceType = "port"
+	TypeSubnet          ResourceType = "subnet"
+	TypeNetwork         ResourceType = "network"
+	TypeSecurityGroup   ResourceType = "security_group"
+	TypeSnapshot        ResourceType = "snapshot"
+	TypeVolume          ResourceType = "volume"
+	TypeObject          ResourceType = "object"
+	TypeContainer       ResourceType = "container"
+)
+
+// Resource is a resource owned by the project.
+type Resource struct {
+	Type ResourceType
+
+	// ID is the ID of the resource. For router interfaces it is the ID of the
+	// interface port, and for objects and containers it is their name.
+	ID string
+
+	// Name is the name of the resource, if it has one.
+	Name string
+
+	// Parent is the ID of the router a router interface belongs to, or the
+	// name of the container an object is stored in.
+	Parent string
+}
@@ -0,0 +1,145 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ProjectID is the ID of the project being purged.
+const ProjectID = "9fe1d3b6d7a44a5f8e6ac1a3b9b8d4a7"
+
+// handleList serves body to the list requests made at path. Networking
+// requests, made under /v2.0/, must be filtered by ProjectID.
+func handleList(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		if strings.HasPrefix(r.URL.Path, "/v2.0/") {
+			th.TestFormValues(t, r, map[string]string{"tenant_id": ProjectID})
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	})
+}
+
+// handleSwiftList serves body to the first page of the list requests made at
+// path, and an empty page to the following ones.
+func handleSwiftList(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("marker") != "" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandleDiscoverySuccessfully sets up the test server to respond to the list
+// requests issued by Discover for the compute and networking services.
+func HandleDiscoverySuccessfully(t *testing.T) {
+	handleList(t, "/servers/detail", `
+{
+	"servers": [
+		{"id": "s1", "name": "ci-web", "status": "ACTIVE", "created": "2016-10-01T10:00:00Z"},
+		{"id": "s2", "name": "prod-web", "status": "ACTIVE", "created": "2016-10-01T10:00:00Z"}
+	]
+}`)
+
+	handleList(t, "/v2.0/networks", `
+{
+	"networks": [
+		{"id": "n1", "name": "ci-net"},
+		{"id": "n2", "name": "prod-net"}
+	]
+}`)
+
+	handleList(t, "/v2.0/subnets", `
+{
+	"subnets": [
+		{"id": "sn1", "name": "", "network_id": "n1"},
+		{"id": "sn2", "name": "prod-subnet", "network_id": "n2"}
+	]
+}`)
+
+	handleList(t, "/v2.0/routers", `
+{
+	"routers": [
+		{"id": "r1", "name": "ci-router"}
+	]
+}`)
+
+	handleList(t, "/v2.0/ports", `
+{
+	"ports": [
+		{"id": "p1", "name": "", "network_id": "n2", "device_owner": "compute:nova", "device_id": "s1"},
+		{"id": "p2", "name": "", "network_id": "n1", "device_owner": "network:dhcp", "device_id": "dhcp"},
+		{"id": "p3", "name": "", "network_id": "n2", "device_owner": "network:router_interface", "device_id": "r1", "fixed_ips": [{"subnet_id": "sn2"}]},
+		{"id": "p4", "name": "vip", "network_id": "n1", "device_owner": "", "device_id": ""},
+		{"id": "p5", "name": "", "network_id": "n2", "device_owner": "compute:nova", "device_id": "s2"}
+	]
+}`)
+
+	handleList(t, "/v2.0/floatingips", `
+{
+	"floatingips": [
+		{"id": "f1", "floating_ip_address": "172.24.4.3", "port_id": "p1"},
+		{"id": "f2", "floating_ip_address": "172.24.4.4", "port_id": "p5"}
+	]
+}`)
+
+	handleList(t, "/v2.0/security-groups", `
+{
+	"security_groups": [
+		{"id": "g1", "name": "default"},
+		{"id": "g2", "name": "ci-ssh"},
+		{"id": "g3", "name": "prod-ssh"}
+	]
+}`)
+}
+
+// HandleStorageDiscoverySuccessfully sets up the test server to respond to the
+// list requests issued by Discover for the orchestration, block storage and
+// object storage services, found under /heat/, /cinder/, /cinderv1/ and
+// /swift/ respectively.
+func HandleStorageDiscoverySuccessfully(t *testing.T) {
+	handleList(t, "/heat/stacks", `
+{
+	"stacks": [
+		{"id": "st1", "stack_name": "ci-stack", "stack_status": "CREATE_COMPLETE", "creation_time": "2016-10-01T10:00:00"},
+		{"id": "st2", "stack_name": "ci-new-stack", "stack_status": "CREATE_COMPLETE", "creation_time": "2016-12-01T10:00:00"},
+		{"id": "st3", "stack_name": "prod-stack", "stack_status": "CREATE_COMPLETE", "creation_time": "2016-10-01T10:00:00"}
+	]
+}`)
+
+	handleList(t, "/cinder/volumes/detail", `
+{
+	"volumes": [
+		{"id": "v1", "name": "ci-vol", "created_at": "2016-10-01T10:00:00.000000"},
+		{"id": "v2", "name": "prod-vol", "created_at": "2016-10-01T10:00:00.000000"}
+	]
+}`)
+
+	handleList(t, "/cinderv1/snapshots", `
+{
+	"snapshots": [
+		{"id": "sn1", "display_name": "", "volume_id": "v1", "created_at": "2016-10-01T10:00:00.000000Z"},
+		{"id": "sn2", "display_name": "ci-snap", "volume_id": "v2", "created_at": "2016-10-01T10:00:00.000000Z"},
+		{"id": "sn3", "display_name": "prod-snap", "volume_id": "v2", "created_at": "2016-10-01T10:00:00.000000Z"}
+	]
+}`)
+
+	handleSwiftList(t, "/swift/", `[{"name": "ci-bucket"}, {"name": "prod-bucket"}]`)
+	handleSwiftList(t, "/swift/ci-bucket", `[{"name": "a.txt"}, {"name": "b.txt"}]`)
+}
@@ -0,0 +1,329 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/bulk"
+	"github.com/gophercloud/gophercloud/openstack/purge"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// serviceClient returns a client for the service found under path on the
+// test server.
+func serviceClient(path string) *gophercloud.ServiceClient {
+	sc := client.ServiceClient()
+	sc.Endpoint += path
+	return sc
+}
+
+func networkClient() *gophercloud.ServiceClient {
+	return serviceClient("v2.0/")
+}
+
+func storageClients() purge.Clients {
+	return purge.Clients{
+		Orchestration:  serviceClient("heat/"),
+		BlockStorage:   serviceClient("cinder/"),
+		BlockStorageV1: serviceClient("cinderv1/"),
+		ObjectStorage:  serviceClient("swift/"),
+	}
+}
+
+// deletions records the requests served by the handlers of a test.
+type deletions struct {
+	sync.Mutex
+	paths []string
+}
+
+func (d *deletions) add(path string) {
+	d.Lock()
+	defer d.Unlock()
+	d.paths = append(d.paths, path)
+}
+
+// checkOrder checks that the paths of each group were deleted, one group
+// after the other.
+func (d *deletions) checkOrder(t *testing.T, groups ...[]string) {
+	paths := d.paths
+	for _, group := range groups {
+		th.AssertEquals(t, true, len(paths) >= len(group))
+		actual := append([]string(nil), paths[:len(group)]...)
+		sort.Strings(actual)
+		th.CheckDeepEquals(t, group, actual)
+		paths = paths[len(group):]
+	}
+	th.CheckEquals(t, 0, len(paths))
+}
+
+func TestDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDiscoverySuccessfully(t)
+
+	clients := purge.Clients{
+		Compute: client.ServiceClient(),
+		Network: networkClient(),
+	}
+	actual, err := purge.Purge(clients, purge.Opts{
+		ProjectID:  ProjectID,
+		NamePrefix: "ci-",
+		DryRun:     true,
+	})
+	th.AssertNoErr(t, err)
+
+	expected := []purge.Resource{
+		{Type: purge.TypeServer, ID: "s1", Name: "ci-web"},
+		{Type: purge.TypeFloatingIP, ID: "f1", Name: "172.24.4.3"},
+		{Type: purge.TypeRouterInterface, ID: "p3", Parent: "r1"},
+		{Type: purge.TypeRouter, ID: "r1", Name: "ci-router"},
+		{Type: purge.TypePort, ID: "p1"},
+		{Type: purge.TypePort, ID: "p4", Name: "vip"},
+		{Type: purge.TypeSubnet, ID: "sn1"},
+		{Type: purge.TypeNetwork, ID: "n1", Name: "ci-net"},
+		{Type: purge.TypeSecurityGroup, ID: "g2", Name: "ci-ssh"},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestPurgeRequiresProjectID(t *testing.T) {
+	_, err := purge.Discover(purge.Clients{Network: networkClient()}, purge.Opts{})
+	_, ok := err.(gophercloud.ErrMissingInput)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestPurge(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	for _, path := range []string{"/v2.0/subnets", "/v2.0/routers", "/v2.0/ports", "/v2.0/floatingips"} {
+		handleList(t, path, `{"subnets": [], "routers": [], "ports": [], "floatingips": []}`)
+	}
+	handleList(t, "/v2.0/security-groups", `{"security_groups": [{"id": "g1", "name": "default"}]}`)
+	handleList(t, "/v2.0/networks", `{"networks": [{"id": "n1", "name": "ci-net"}, {"id": "n2", "name": "gone"}]}`)
+
+	var deleted []string
+	th.Mux.HandleFunc("/v2.0/networks/n1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		deleted = append(deleted, "n1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	th.Mux.HandleFunc("/v2.0/networks/n2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	actual, err := purge.Purge(purge.Clients{Network: networkClient()}, purge.Opts{ProjectID: ProjectID, Concurrency: 1})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(actual))
+	th.CheckDeepEquals(t, []string{"n1"}, deleted)
+}
+
+func TestDiscoverProjectMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDiscoverySuccessfully(t)
+
+	compute := client.ServiceClient()
+	compute.AuthIdentity = &gophercloud.AuthIdentity{ProjectID: "a2d4b5c7e9f14b3a8c6d2e1f0a9b8c7d"}
+
+	_, err := purge.Discover(purge.Clients{Compute: compute}, purge.Opts{ProjectID: ProjectID})
+	mismatch, ok := err.(purge.ErrProjectMismatch)
+	th.AssertEquals(t, true, ok)
+	th.CheckEquals(t, "a2d4b5c7e9f14b3a8c6d2e1f0a9b8c7d", mismatch.TokenProjectID)
+
+	// A token scoped to the project being purged is accepted.
+	compute.AuthIdentity.ProjectID = ProjectID
+	actual, err := purge.Discover(purge.Clients{Compute: compute}, purge.Opts{ProjectID: ProjectID})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, len(actual))
+}
+
+func TestDiscoverStorage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStorageDiscoverySuccessfully(t)
+
+	actual, err := purge.Discover(storageClients(), purge.Opts{NamePrefix: "ci-"})
+	th.AssertNoErr(t, err)
+
+	expected := []purge.Resource{
+		{Type: purge.TypeStack, ID: "st1", Name: "ci-stack"},
+		{Type: purge.TypeStack, ID: "st2", Name: "ci-new-stack"},
+		{Type: purge.TypeSnapshot, ID: "sn1"},
+		{Type: purge.TypeSnapshot, ID: "sn2", Name: "ci-snap"},
+		{Type: purge.TypeVolume, ID: "v1", Name: "ci-vol"},
+		{Type: purge.TypeObject, ID: "a.txt", Name: "a.txt", Parent: "ci-bucket"},
+		{Type: purge.TypeObject, ID: "b.txt", Name: "b.txt", Parent: "ci-bucket"},
+		{Type: purge.TypeContainer, ID: "ci-bucket", Name: "ci-bucket"},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestDiscoverCreatedBefore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStorageDiscoverySuccessfully(t)
+
+	actual, err := purge.Discover(storageClients(), purge.Opts{
+		NamePrefix:    "ci-",
+		CreatedBefore: time.Date(2016, 11, 1, 0, 0, 0, 0, time.UTC),
+	})
+	th.AssertNoErr(t, err)
+
+	// Containers do not report a creation time, so neither they nor their
+	// objects are purged.
+	expected := []purge.Resource{
+		{Type: purge.TypeStack, ID: "st1", Name: "ci-stack"},
+		{Type: purge.TypeSnapshot, ID: "sn1"},
+		{Type: purge.TypeSnapshot, ID: "sn2", Name: "ci-snap"},
+		{Type: purge.TypeVolume, ID: "v1", Name: "ci-vol"},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+// handleDeleteAndWait serves the deletion of the resource at path, and then
+// returns each of statuses, formatted into format, to the requests made to
+// get it. Once they are exhausted, the resource is not found.
+func handleDeleteAndWait(t *testing.T, d *deletions, path, format string, statuses ...string) {
+	var mu sync.Mutex
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "DELETE":
+			d.add(path)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			mu.Lock()
+			defer mu.Unlock()
+			if len(statuses) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, format, statuses[0])
+			statuses = statuses[1:]
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, path)
+		}
+	})
+}
+
+func TestPurgeWaitsForDeletion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStorageDiscoverySuccessfully(t)
+
+	d := new(deletions)
+	stack := `{"stack": {"id": "st1", "stack_status": "%s"}}`
+	handleDeleteAndWait(t, d, "/heat/stacks/ci-stack/st1", stack, "DELETE_IN_PROGRESS", "DELETE_COMPLETE")
+	handleDeleteAndWait(t, d, "/heat/stacks/ci-new-stack/st2", stack)
+	snapshot := `{"snapshot": {"id": "sn1", "status": "%s"}}`
+	handleDeleteAndWait(t, d, "/cinderv1/snapshots/sn1", snapshot, "deleting")
+	handleDeleteAndWait(t, d, "/cinderv1/snapshots/sn2", snapshot, "error", "deleted")
+	handleDeleteAndWait(t, d, "/cinder/volumes/v1", "")
+
+	clients := storageClients()
+	clients.ObjectStorage = nil
+	_, err := purge.Purge(clients, purge.Opts{NamePrefix: "ci-", Timeout: 10})
+	th.AssertNoErr(t, err)
+
+	d.checkOrder(t,
+		[]string{"/heat/stacks/ci-new-stack/st2", "/heat/stacks/ci-stack/st1"},
+		[]string{"/cinderv1/snapshots/sn1", "/cinderv1/snapshots/sn2"},
+		[]string{"/cinder/volumes/v1"},
+	)
+}
+
+func TestPurgeDeleteFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStorageDiscoverySuccessfully(t)
+
+	d := new(deletions)
+	stack := `{"stack": {"id": "st1", "stack_status": "%s"}}`
+	handleDeleteAndWait(t, d, "/heat/stacks/ci-stack/st1", stack, "DELETE_FAILED")
+	handleDeleteAndWait(t, d, "/heat/stacks/ci-new-stack/st2", stack)
+
+	clients := storageClients()
+	clients.ObjectStorage = nil
+	_, err := purge.Purge(clients, purge.Opts{NamePrefix: "ci-", Timeout: 10})
+
+	failed, ok := err.(*bulk.ErrOperationsFailed)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 1, len(failed.Errors))
+	th.CheckEquals(t, 0, failed.Errors[0].Index)
+	_, ok = failed.Errors[0].Err.(gophercloud.ErrResourceFailed)
+	th.CheckEquals(t, true, ok)
+
+	// The snapshots and volumes are left alone once a stack failed.
+	d.checkOrder(t, []string{"/heat/stacks/ci-new-stack/st2", "/heat/stacks/ci-stack/st1"})
+}
+
+func TestPurgeVolumeInUse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleList(t, "/cinder/volumes/detail", `{"volumes": [{"id": "v1", "name": "ci-vol"}]}`)
+
+	// The volume is still being detached from a purged server when it is
+	// first deleted.
+	var attempts int
+	th.Mux.HandleFunc("/cinder/volumes/v1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		attempts++
+		if attempts == 1 {
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"badRequest": {"code": 400, "message": "Invalid volume: Volume status must be available or error or error_restoring or error_extending or error_managing and must not be migrating, attached, belong to a group, have snapshots or be disassociated from snapshots after volume transfer. Current status: in-use."}}`)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := purge.Purge(purge.Clients{BlockStorage: serviceClient("cinder/")}, purge.Opts{Timeout: 10})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, attempts)
+}
+
+func TestPurgeObjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	d := new(deletions)
+	handleSwiftList(t, "/swift/", `[{"name": "ci-bucket"}]`)
+	th.Mux.HandleFunc("/swift/ci-bucket", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			d.add(r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		if r.URL.Query().Get("marker") != "" {
+			fmt.Fprintf(w, `[]`)
+			return
+		}
+		fmt.Fprintf(w, `[{"name": "a.txt"}, {"name": "b.txt"}]`)
+	})
+	th.Mux.HandleFunc("/swift/ci-bucket/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		d.add(r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	actual, err := purge.Purge(purge.Clients{ObjectStorage: serviceClient("swift/")}, purge.Opts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(actual))
+
+	d.checkOrder(t,
+		[]string{"/swift/ci-bucket/a.txt", "/swift/ci-bucket/b.txt"},
+		[]string{"/swift/ci-bucket"},
+	)
+}
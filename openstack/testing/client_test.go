@@ -1,12 +1,18 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/ec2tokens"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/oauth1"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
@@ -95,7 +101,18 @@ func TestAuthenticatedClientV2(t *testing.T) {
 				"access": {
 					"token": {
 						"id": "01234567890",
-						"expires": "2014-10-01T10:00:00.000000Z"
+						"expires": "2014-10-01T10:00:00.000000Z",
+						"tenant": {
+							"id": "t1000",
+							"name": "demo"
+						}
+					},
+					"user": {
+						"id": "u1000",
+						"name": "me",
+						"roles": [
+							{ "name": "member" }
+						]
 					},
 					"serviceCatalog": [
 						{
@@ -161,6 +178,423 @@ func TestAuthenticatedClientV2(t *testing.T) {
 	client, err := openstack.AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "01234567890", client.TokenID)
+
+	th.AssertEquals(t, true, client.AuthIdentity != nil)
+	th.CheckEquals(t, "u1000", client.AuthIdentity.UserID)
+	th.CheckEquals(t, "me", client.AuthIdentity.UserName)
+	th.CheckEquals(t, "t1000", client.AuthIdentity.ProjectID)
+	th.CheckEquals(t, "demo", client.AuthIdentity.ProjectName)
+	th.CheckEquals(t, true, client.AuthIdentity.HasRole("member"))
+}
+
+func TestAuthenticateV3ApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["application_credential"],
+						"application_credential": {
+							"id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+							"secret": "ExtremelySecret"
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "0123456789")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	options := gophercloud.AuthOptions{
+		ApplicationCredentialID:     "c4859fb437df4b87a51a8f5adcfb0bc7",
+		ApplicationCredentialSecret: "ExtremelySecret",
+		TenantID:                    "ignored",
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "0123456789", client.TokenID)
+}
+
+func TestAuthenticateV3TrustScope(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": { "id": "trustee", "password": "secret" }
+						}
+					},
+					"scope": {
+						"OS-TRUST:trust": { "id": "987fe7" }
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "0123456789")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	options := gophercloud.AuthOptions{
+		UserID:   "trustee",
+		Password: "secret",
+		TrustID:  "987fe7",
+		TenantID: "ignored",
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "0123456789", client.TokenID)
+}
+
+func TestAuthenticateV3DomainScope(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": { "name": "me", "password": "secret", "domain": { "name": "default" } }
+						}
+					},
+					"scope": {
+						"domain": { "id": "7a9f3c" }
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "0123456789")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	options := gophercloud.AuthOptions{
+		Username:   "me",
+		Password:   "secret",
+		DomainName: "default",
+		TenantName: "ignored",
+		Scope:      &gophercloud.AuthScope{DomainID: "7a9f3c"},
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "0123456789", client.TokenID)
+}
+
+func TestRescopeV3(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		var token, project string
+		var s struct {
+			Auth struct {
+				Identity struct {
+					Methods []string `json:"methods"`
+					Token   struct {
+						ID string `json:"id"`
+					} `json:"token"`
+				} `json:"identity"`
+				Scope struct {
+					Project struct {
+						ID string `json:"id"`
+					} `json:"project"`
+				} `json:"scope"`
+			} `json:"auth"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&s))
+		if s.Auth.Identity.Methods[0] == "password" {
+			token = "password-token"
+			project = "project"
+		} else {
+			th.CheckEquals(t, "other-project", s.Auth.Scope.Project.ID)
+			token = "rescoped-" + s.Auth.Identity.Token.ID
+			project = "other-project"
+		}
+
+		w.Header().Add("X-Subject-Token", token)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2013-02-02T18:30:59.000000Z",
+				"user": { "id": "me" },
+				"project": { "id": "%s" },
+				"roles": [ { "id": "1", "name": "member" } ]
+			}
+		}`, project)
+	})
+
+	options := gophercloud.AuthOptions{
+		UserID:      "me",
+		Password:    "secret",
+		TenantID:    "project",
+		AllowReauth: true,
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "password-token", client.TokenID)
+	th.CheckEquals(t, "me", client.AuthIdentity.UserID)
+	th.CheckEquals(t, "project", client.AuthIdentity.ProjectID)
+	th.CheckEquals(t, true, client.AuthIdentity.HasRole("member"))
+
+	err = openstack.RescopeV3(client, &tokens.Scope{ProjectID: "other-project"}, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "rescoped-password-token", client.TokenID)
+	th.CheckEquals(t, "other-project", client.AuthIdentity.ProjectID)
+
+	// Re-authentication rescopes the new token, every time.
+	for i := 0; i < 2; i++ {
+		client.TokenID = "expired"
+		th.AssertNoErr(t, client.ReauthFunc())
+		th.CheckEquals(t, "rescoped-password-token", client.TokenID)
+	}
+}
+
+func TestRescopeV3Unauthorized(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	allowRescope := true
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		var s struct {
+			Auth struct {
+				Identity struct {
+					Methods []string `json:"methods"`
+				} `json:"identity"`
+			} `json:"auth"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&s))
+		token := "password-token"
+		if s.Auth.Identity.Methods[0] == "token" {
+			if !allowRescope {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			token = "rescoped-token"
+		}
+
+		w.Header().Add("X-Subject-Token", token)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2013-02-02T18:30:59.000000Z",
+				"user": { "id": "me" }
+			}
+		}`)
+	})
+
+	options := gophercloud.AuthOptions{
+		UserID:      "me",
+		Password:    "secret",
+		AllowReauth: true,
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+
+	err = openstack.RescopeV3(client, &tokens.Scope{ProjectID: "other-project"}, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "rescoped-token", client.TokenID)
+
+	// The user lost its role on the project: rescoping fails rather than
+	// re-authenticating forever.
+	allowRescope = false
+	err = client.ReauthFunc()
+	if _, ok := err.(gophercloud.ErrDefault401); !ok {
+		t.Fatalf("Expected ErrDefault401, got %#v", err)
+	}
+
+	err = openstack.RescopeV3(client, &tokens.Scope{ProjectID: "other-project"}, gophercloud.EndpointOpts{})
+	if _, ok := err.(gophercloud.ErrDefault401); !ok {
+		t.Fatalf("Expected ErrDefault401, got %#v", err)
+	}
+}
+
+func TestRescopeV3Concurrent(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("X-Subject-Token", "new-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2013-02-02T18:30:59.000000Z",
+				"user": { "id": "me" }
+			}
+		}`)
+	})
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") == "expired-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	options := gophercloud.AuthOptions{
+		UserID:      "me",
+		Password:    "secret",
+		AllowReauth: true,
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	client.SetToken("expired-token")
+
+	// Requests re-authenticate while the client is rescoped, which must not
+	// race on its ReauthFunc (see go test -race).
+	sc := &gophercloud.ServiceClient{ProviderClient: client, Endpoint: th.Endpoint()}
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = sc.Delete(sc.ServiceURL("servers"), nil)
+		}(i)
+	}
+	err = openstack.RescopeV3(client, &tokens.Scope{ProjectID: "other-project"}, gophercloud.EndpointOpts{})
+	wg.Wait()
+
+	th.AssertNoErr(t, err)
+	for _, err := range errs {
+		th.CheckNoErr(t, err)
+	}
+}
+
+func TestAuthenticateV3EC2(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var signatures []string
+	th.Mux.HandleFunc("/v3/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		var s struct {
+			Credentials struct {
+				Access    string `json:"access"`
+				Signature string `json:"signature"`
+			} `json:"credentials"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&s))
+		th.CheckEquals(t, "f741662395b249c9b8acdebf1722c5ae", s.Credentials.Access)
+		signatures = append(signatures, s.Credentials.Signature)
+
+		w.Header().Add("X-Subject-Token", "ec2-token")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2013-02-02T18:30:59.000000Z",
+				"catalog": [
+					{
+						"type": "object-store",
+						"endpoints": [
+							{"interface": "public", "region": "RegionOne", "url": "https://object-store.example.com/v1/"}
+						]
+					}
+				]
+			}
+		}`)
+	})
+
+	opts := ec2tokens.AuthOptions{
+		Access:      "f741662395b249c9b8acdebf1722c5ae",
+		Secret:      "6a61eb0296034c89b49cc51dad9e6f56",
+		AllowReauth: true,
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3EC2(client, opts, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "ec2-token", client.TokenID)
+
+	url, err := client.EndpointLocator(gophercloud.EndpointOpts{Type: "object-store", Availability: gophercloud.AvailabilityPublic})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://object-store.example.com/v1/", url)
+
+	client.TokenID = "expired"
+	th.AssertNoErr(t, client.ReauthFunc())
+	th.CheckEquals(t, "ec2-token", client.TokenID)
+	th.CheckEquals(t, 2, len(signatures))
+}
+
+func TestAuthenticateV3OAuth1(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var authorizations []string
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+
+		w.Header().Add("X-Subject-Token", "oauth1-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"methods": ["oauth1"],
+				"expires_at": "2013-02-02T18:30:59.000000Z",
+				"user": { "id": "ce9e07" },
+				"project": { "id": "b9fca3" }
+			}
+		}`)
+	})
+
+	opts := oauth1.AuthOptions{
+		ConsumerKey:       "7fea2d",
+		ConsumerSecret:    "4d2d5e",
+		AccessToken:       "accd36",
+		AccessTokenSecret: "aa47da",
+		AllowReauth:       true,
+	}
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3OAuth1(client, opts, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "oauth1-token", client.TokenID)
+	th.CheckEquals(t, "b9fca3", client.AuthIdentity.ProjectID)
+
+	client.TokenID = "expired"
+	th.AssertNoErr(t, client.ReauthFunc())
+	th.CheckEquals(t, "oauth1-token", client.TokenID)
+	th.AssertEquals(t, 2, len(authorizations))
+	th.CheckEquals(t, true, strings.HasPrefix(authorizations[0], "OAuth "))
+	th.CheckEquals(t, false, authorizations[0] == authorizations[1])
 }
 
 func testAuthenticatedClientFails(t *testing.T, endpoint string) {
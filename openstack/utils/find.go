@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// NamedResource is the ID and name of a listed resource.
+type NamedResource struct {
+	ID   string
+	Name string
+}
+
+// FindOpts describes how to look up a resource of a given type by its name or
+// ID. Resource packages build one to implement their IDFromName and FindID
+// functions.
+type FindOpts struct {
+	// ResourceType is the kind of resource being looked up, e.g. "server". It is
+	// only used in error messages.
+	ResourceType string
+
+	// Get, if provided, fetches a resource by ID and returns the resulting
+	// error. It is used by FindID to check whether the given value is the ID of
+	// an existing resource before looking it up by name.
+	Get func(id string) error
+
+	// List returns a Pager over the resources that may be named name. If the API
+	// supports a server-side name filter, List should use it. Resources are
+	// matched by exact name whether or not the filter is applied.
+	List func(name string) pagination.Pager
+
+	// Key is the name of the array that holds the resources in the pages
+	// returned by List, e.g. "servers". The ID and name of each resource are
+	// read from its "id" field and its NameKey field.
+	Key string
+
+	// NameKey is the field that holds the name of a resource. It defaults to
+	// "name".
+	NameKey string
+
+	// Extract, if provided, returns the ID and name of every resource in a
+	// page, in place of Key and NameKey.
+	Extract func(page pagination.Page) ([]NamedResource, error)
+
+	// UniqueNames, if true, stops the lookup at the first resource named
+	// name. It should only be set for resource types whose names the service
+	// keeps unique, since duplicates are not detected.
+	UniqueNames bool
+
+	// PointerErrors, if true, makes IDFromName and FindID return
+	// *gophercloud.ErrResourceNotFound and
+	// *gophercloud.ErrMultipleResourcesFound rather than values, for packages
+	// whose lookups have always returned pointers.
+	PointerErrors bool
+}
+
+// IDFromName returns the ID of the only resource named name. It returns a
+// gophercloud.ErrResourceNotFound if no resource has that name, and a
+// gophercloud.ErrMultipleResourcesFound listing the candidate IDs if more than
+// one does. See FindOpts.PointerErrors.
+func IDFromName(name string, opts FindOpts) (string, error) {
+	var ids []string
+	err := opts.List(name).EachPage(func(page pagination.Page) (bool, error) {
+		resources, err := opts.extract(page)
+		if err != nil {
+			return false, err
+		}
+		for _, r := range resources {
+			if r.Name == name {
+				ids = append(ids, r.ID)
+				if opts.UniqueNames {
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(ids) {
+	case 0:
+		err := gophercloud.ErrResourceNotFound{Name: name, ResourceType: opts.ResourceType}
+		if opts.PointerErrors {
+			return "", &err
+		}
+		return "", err
+	case 1:
+		return ids[0], nil
+	default:
+		err := gophercloud.ErrMultipleResourcesFound{Name: name, Count: len(ids), ResourceType: opts.ResourceType, IDs: ids}
+		if opts.PointerErrors {
+			return "", &err
+		}
+		return "", err
+	}
+}
+
+// FindID returns nameOrID if it is the ID of an existing resource, and
+// otherwise looks it up as a name with IDFromName. If opts.Get is not
+// provided, nameOrID is always treated as a name.
+func FindID(nameOrID string, opts FindOpts) (string, error) {
+	if opts.Get != nil {
+		switch err := opts.Get(nameOrID).(type) {
+		case nil:
+			return nameOrID, nil
+		case gophercloud.ErrDefault404, gophercloud.ErrDefault400:
+		default:
+			return "", err
+		}
+	}
+	return IDFromName(nameOrID, opts)
+}
+
+// extract returns the ID and name of every resource in page.
+func (opts FindOpts) extract(page pagination.Page) ([]NamedResource, error) {
+	if opts.Extract != nil {
+		return opts.Extract(page)
+	}
+
+	var s map[string]json.RawMessage
+	err := page.(interface {
+		ExtractInto(interface{}) error
+	}).ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []map[string]interface{}
+	if raw, ok := s[opts.Key]; ok {
+		if err := json.Unmarshal(raw, &all); err != nil {
+			return nil, err
+		}
+	}
+
+	nameKey := opts.NameKey
+	if nameKey == "" {
+		nameKey = "name"
+	}
+	resources := make([]NamedResource, len(all))
+	for i, r := range all {
+		resources[i].ID, _ = r["id"].(string)
+		resources[i].Name, _ = r[nameKey].(string)
+	}
+	return resources, nil
+}
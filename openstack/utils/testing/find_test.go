@@ -0,0 +1,214 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+type thingPage struct {
+	pagination.LinkedPageBase
+}
+
+func (r thingPage) IsEmpty() (bool, error) {
+	things, err := extractThings(r)
+	return len(things) == 0, err
+}
+
+func extractThings(r pagination.Page) ([]utils.NamedResource, error) {
+	var s struct {
+		Things []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"things"`
+	}
+	err := (r.(thingPage)).ExtractInto(&s)
+	resources := make([]utils.NamedResource, len(s.Things))
+	for i, t := range s.Things {
+		resources[i] = utils.NamedResource{ID: t.ID, Name: t.Name}
+	}
+	return resources, err
+}
+
+// handleListThings serves two pages of things, in which "twin" is the name of
+// a thing on each page, and returns a pointer to the number of pages served.
+func handleListThings(t *testing.T) *int {
+	var pages int
+	th.Mux.HandleFunc("/things", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		pages++
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprintf(w, `
+				{
+					"things": [
+						{"id": "1", "name": "single"},
+						{"id": "2", "name": "twin"}
+					],
+					"links": {"next": "%s"}
+				}
+			`, th.Server.URL+"/things?marker=2")
+		case "2":
+			fmt.Fprintf(w, `{"things": [{"id": "3", "name": "twin"}]}`)
+		default:
+			t.Fatalf("unexpected marker %q", r.URL.Query().Get("marker"))
+		}
+	})
+	return &pages
+}
+
+func findOpts(get func(id string) error) utils.FindOpts {
+	return utils.FindOpts{
+		ResourceType: "thing",
+		Key:          "things",
+		Get:          get,
+		List: func(name string) pagination.Pager {
+			return pagination.NewPager(client.ServiceClient(), client.ServiceClient().ServiceURL("things"), func(r pagination.PageResult) pagination.Page {
+				return thingPage{pagination.LinkedPageBase{PageResult: r}}
+			})
+		},
+	}
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleListThings(t)
+
+	id, err := utils.IDFromName("single", findOpts(nil))
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1", id)
+}
+
+func TestIDFromNameExtract(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleListThings(t)
+
+	opts := findOpts(nil)
+	opts.Key = ""
+	opts.Extract = extractThings
+
+	id, err := utils.IDFromName("single", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1", id)
+}
+
+func TestIDFromNameNameKey(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/things", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"things": [{"id": "1", "display_name": "single", "name": "other"}]}`)
+	})
+
+	opts := findOpts(nil)
+	opts.NameKey = "display_name"
+
+	id, err := utils.IDFromName("single", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1", id)
+}
+
+func TestIDFromNameNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleListThings(t)
+
+	_, err := utils.IDFromName("missing", findOpts(nil))
+	notFound, ok := err.(gophercloud.ErrResourceNotFound)
+	th.AssertEquals(t, true, ok)
+	th.CheckEquals(t, "missing", notFound.Name)
+	th.CheckEquals(t, "thing", notFound.ResourceType)
+}
+
+func TestIDFromNameMultiple(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleListThings(t)
+
+	_, err := utils.IDFromName("twin", findOpts(nil))
+	multiple, ok := err.(gophercloud.ErrMultipleResourcesFound)
+	th.AssertEquals(t, true, ok)
+	th.CheckEquals(t, 2, multiple.Count)
+	th.CheckDeepEquals(t, []string{"2", "3"}, multiple.IDs)
+}
+
+func TestIDFromNamePointerErrors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleListThings(t)
+
+	opts := findOpts(nil)
+	opts.PointerErrors = true
+
+	_, err := utils.IDFromName("missing", opts)
+	_, ok := err.(*gophercloud.ErrResourceNotFound)
+	th.CheckEquals(t, true, ok)
+
+	_, err = utils.IDFromName("twin", opts)
+	_, ok = err.(*gophercloud.ErrMultipleResourcesFound)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestIDFromNameUniqueNames(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	pages := handleListThings(t)
+
+	opts := findOpts(nil)
+	opts.UniqueNames = true
+
+	id, err := utils.IDFromName("twin", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2", id)
+	th.CheckEquals(t, 1, *pages)
+}
+
+func TestFindIDByID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	pages := handleListThings(t)
+
+	id, err := utils.FindID("3", findOpts(func(id string) error {
+		th.CheckEquals(t, "3", id)
+		return nil
+	}))
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "3", id)
+	th.CheckEquals(t, 0, *pages)
+}
+
+func TestFindIDByName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleListThings(t)
+
+	id, err := utils.FindID("single", findOpts(func(id string) error {
+		return gophercloud.ErrDefault404{}
+	}))
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1", id)
+}
+
+func TestFindIDGetError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	pages := handleListThings(t)
+
+	_, err := utils.FindID("single", findOpts(func(id string) error {
+		return gophercloud.ErrDefault500{}
+	}))
+	_, ok := err.(gophercloud.ErrDefault500)
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, 0, *pages)
+}
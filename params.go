@@ -97,6 +97,12 @@ func BuildRequestBody(opts interface{}, parent string) (map[string]interface{},
 				}
 			}
 
+			// fields that are not serialized, such as times that the caller
+			// formats itself, are not validated as nested request bodies
+			if f.Tag.Get("json") == "-" {
+				continue
+			}
+
 			if v.Kind() == reflect.Struct || (v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct) {
 				if zero {
 					//fmt.Printf("value before change: %+v\n", optsValue.Field(i))
@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
@@ -53,6 +55,11 @@ type ProviderClient struct {
 	// TokenID is the ID of the most recently issued valid token.
 	TokenID string
 
+	// AuthIdentity describes the user, scope and roles of the token. It is
+	// set by the authentication functions of the openstack package, and nil
+	// otherwise.
+	AuthIdentity *AuthIdentity
+
 	// EndpointLocator describes how this provider discovers the endpoints for
 	// its constituent services.
 	EndpointLocator EndpointLocator
@@ -65,19 +72,132 @@ type ProviderClient struct {
 
 	// ReauthFunc is the function used to re-authenticate the user if the request
 	// fails with a 401 HTTP response code. This a needed because there may be multiple
-	// authentication functions for different Identity service versions.
+	// authentication functions for different Identity service versions. Once the
+	// ProviderClient is shared between goroutines, use SetReauthFunc to replace it.
 	ReauthFunc func() error
 
+	// Metrics, if set, is notified of every request issued through this
+	// ProviderClient.
+	Metrics MetricsReporter
+
+	// Auditor, if set, records every state-changing request issued through
+	// this ProviderClient.
+	Auditor *Auditor
+
+	// Middleware is the chain of middleware every request issued through this
+	// ProviderClient is passed through, outermost first. See Use.
+	Middleware []Middleware
+
 	Debug bool
+
+	// mut guards TokenID, AuthIdentity, EndpointLocator and ReauthFunc, and
+	// reauthmut serializes re-authentication. Both are nil unless UseTokenLock
+	// was called.
+	mut       *sync.RWMutex
+	reauthmut *sync.Mutex
+}
+
+// UseTokenLock makes the ProviderClient safe for concurrent use: its token is
+// read and replaced under a lock, and requests that are rejected at the same
+// time because the token expired share a single re-authentication.
+// openstack.NewClient calls it. A ProviderClient built by other means must
+// call it before it is shared between goroutines.
+func (client *ProviderClient) UseTokenLock() {
+	client.mut = new(sync.RWMutex)
+	client.reauthmut = new(sync.Mutex)
+}
+
+// Token safely reads the token of the ProviderClient.
+func (client *ProviderClient) Token() string {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.TokenID
+}
+
+// SetToken safely replaces the token of the ProviderClient.
+func (client *ProviderClient) SetToken(t string) {
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.TokenID = t
+}
+
+// SetAuth safely replaces the token, the identity and the endpoint locator of
+// the ProviderClient at once. Authentication functions call it once they have
+// obtained a new token.
+func (client *ProviderClient) SetAuth(tokenID string, identity *AuthIdentity, locator EndpointLocator) {
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.TokenID = tokenID
+	client.AuthIdentity = identity
+	client.EndpointLocator = locator
+}
+
+// Copy safely returns a shallow copy of the ProviderClient. The copy shares
+// the token lock of the ProviderClient, if any.
+func (client *ProviderClient) Copy() *ProviderClient {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	c := *client
+	return &c
+}
+
+// GetReauthFunc safely reads the ReauthFunc of the ProviderClient.
+func (client *ProviderClient) GetReauthFunc() func() error {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.ReauthFunc
+}
+
+// SetReauthFunc safely replaces the ReauthFunc of the ProviderClient. It waits
+// for a re-authentication in progress to complete.
+func (client *ProviderClient) SetReauthFunc(reauth func() error) {
+	if client.reauthmut != nil {
+		client.reauthmut.Lock()
+		defer client.reauthmut.Unlock()
+	}
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.ReauthFunc = reauth
+}
+
+// LocateEndpoint safely calls the EndpointLocator of the ProviderClient.
+func (client *ProviderClient) LocateEndpoint(eo EndpointOpts) (string, error) {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.EndpointLocator(eo)
+}
+
+// authIdentity safely reads the AuthIdentity of the ProviderClient.
+func (client *ProviderClient) authIdentity() *AuthIdentity {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.AuthIdentity
 }
 
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
 // authenticated service requests.
 func (client *ProviderClient) AuthenticatedHeaders() map[string]string {
-	if client.TokenID == "" {
+	token := client.Token()
+	if token == "" {
 		return map[string]string{}
 	}
-	return map[string]string{"X-Auth-Token": client.TokenID}
+	return map[string]string{"X-Auth-Token": token}
 }
 
 // RequestOpts customizes the behavior of the provider.Request() method.
@@ -102,13 +222,118 @@ type RequestOpts struct {
 	// ErrorContext specifies the resource error type to return if an error is encountered.
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
+
+	// serviceType is the type of the ServiceClient that issued the request. It
+	// is reported with request metrics and exposed to middleware.
+	serviceType string
 }
 
 var applicationJSON = "application/json"
 
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
 // header will automatically be provided.
+//
+// The service type reported to Metrics, Auditor and Middleware is only known for requests issued
+// through a ServiceClient, which should be used for every request addressed to a service. It is
+// empty for requests passed to ProviderClient.Request directly, such as version discovery.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if client.Metrics == nil && client.Auditor == nil {
+		return client.doRequest(method, url, options, nil)
+	}
+
+	var m *RequestMetrics
+	if client.Metrics != nil {
+		m = &RequestMetrics{
+			ServiceType: options.serviceType,
+			Method:      method,
+			URLTemplate: URLTemplate(options.serviceType, url),
+		}
+	}
+
+	start := time.Now()
+	resp, err := client.doRequest(method, url, options, m)
+
+	if m != nil {
+		m.Latency = time.Since(start)
+		m.Err = err
+		client.Metrics.ReportRequest(*m)
+	}
+	if client.Auditor != nil {
+		client.Auditor.audit(client.authIdentity(), start, method, url, options, resp, err)
+	}
+
+	return resp, err
+}
+
+// doRequest passes the request through the middleware chain and, if it fails
+// with a 401 response code, re-authenticates and issues it again. If m is not
+// nil, it is updated with the outcome of the request.
+func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, m *RequestMetrics) (*http.Response, error) {
+	token := client.Token()
+	resp, err := client.handler()(method, url, options)
+	if m != nil && resp != nil {
+		m.StatusCode = resp.StatusCode
+	}
+
+	respErr, ok := err.(ErrUnexpectedResponseCode)
+	if !ok || respErr.Actual != http.StatusUnauthorized || client.GetReauthFunc() == nil {
+		return resp, err
+	}
+
+	if m != nil {
+		m.Reauthenticated = true
+	}
+	err = client.reauthenticate(token)
+	if err != nil {
+		e := &ErrUnableToReauthenticate{}
+		e.ErrOriginal = respErr
+		return nil, e
+	}
+	if options.RawBody != nil {
+		if seeker, ok := options.RawBody.(io.Seeker); ok {
+			seeker.Seek(0, 0)
+		}
+	}
+	if m != nil {
+		m.Retries++
+	}
+	resp, err = client.doRequest(method, url, options, m)
+	if err != nil {
+		e := &ErrErrorAfterReauthentication{}
+		e.ErrOriginal = err
+		return nil, e
+	}
+	return resp, nil
+}
+
+// reauthenticate calls ReauthFunc after a request sent with previousToken was
+// rejected. With the token lock in use, concurrent callers wait for each
+// other, and a caller whose token was replaced while it waited does not
+// re-authenticate again.
+func (client *ProviderClient) reauthenticate(previousToken string) error {
+	if client.reauthmut == nil {
+		return client.ReauthFunc()
+	}
+	client.reauthmut.Lock()
+	defer client.reauthmut.Unlock()
+	if client.Token() != previousToken {
+		return nil
+	}
+	return client.GetReauthFunc()()
+}
+
+// handler returns the RequestHandler that issues a single request through
+// every middleware registered with the ProviderClient.
+func (client *ProviderClient) handler() RequestHandler {
+	h := RequestHandler(client.do)
+	for i := len(client.Middleware) - 1; i >= 0; i-- {
+		h = client.Middleware[i](h)
+	}
+	return h
+}
+
+// do issues a single HTTP request and validates its response.
+func (client *ProviderClient) do(method, url string, options *RequestOpts) (*http.Response, error) {
 	var body io.Reader
 	var contentType *string
 
@@ -208,32 +433,9 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 				err = error400er.Error400(respErr)
 			}
 		case http.StatusUnauthorized:
-			if client.ReauthFunc != nil {
-				err = client.ReauthFunc()
-				if err != nil {
-					e := &ErrUnableToReauthenticate{}
-					e.ErrOriginal = respErr
-					return nil, e
-				}
-				if options.RawBody != nil {
-					if seeker, ok := options.RawBody.(io.Seeker); ok {
-						seeker.Seek(0, 0)
-					}
-				}
-				resp, err = client.Request(method, url, options)
-				if err != nil {
-					switch err.(type) {
-					case *ErrUnexpectedResponseCode:
-						e := &ErrErrorAfterReauthentication{}
-						e.ErrOriginal = err.(*ErrUnexpectedResponseCode)
-						return nil, e
-					default:
-						e := &ErrErrorAfterReauthentication{}
-						e.ErrOriginal = err
-						return nil, e
-					}
-				}
-				return resp, nil
+			// Leave the error as-is so that doRequest can re-authenticate.
+			if client.GetReauthFunc() != nil {
+				return resp, respErr
 			}
 			err = ErrDefault401{respErr}
 			if error401er, ok := errType.(Err401er); ok {
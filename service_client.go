@@ -21,6 +21,10 @@ type ServiceClient struct {
 	// as-is, instead.
 	ResourceBase string
 
+	// Type is the service type of this client (e.g. "compute"), as it appears
+	// in the service catalog. It is reported with request metrics.
+	Type string
+
 	Microversion string
 }
 
@@ -46,11 +50,6 @@ func (client *ServiceClient) Get(url string, JSONResponse interface{}, opts *Req
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
-
 	return client.Request("GET", url, opts)
 }
 
@@ -70,11 +69,6 @@ func (client *ServiceClient) Post(url string, JSONBody interface{}, JSONResponse
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
-
 	return client.Request("POST", url, opts)
 }
 
@@ -94,11 +88,6 @@ func (client *ServiceClient) Put(url string, JSONBody interface{}, JSONResponse
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
-
 	return client.Request("PUT", url, opts)
 }
 
@@ -118,11 +107,6 @@ func (client *ServiceClient) Patch(url string, JSONBody interface{}, JSONRespons
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
-
 	return client.Request("PATCH", url, opts)
 }
 
@@ -132,10 +116,31 @@ func (client *ServiceClient) Delete(url string, opts *RequestOpts) (*http.Respon
 		opts = &RequestOpts{}
 	}
 
+	return client.Request("DELETE", url, opts)
+}
+
+// Head calls `Request` with the "HEAD" HTTP verb.
+func (client *ServiceClient) Head(url string, opts *RequestOpts) (*http.Response, error) {
+	if opts == nil {
+		opts = &RequestOpts{}
+	}
+
+	return client.Request("HEAD", url, opts)
+}
+
+// Request sets the headers and options common to every request issued by the
+// ServiceClient, such as its service type, and passes it on to the
+// ProviderClient. Requests with a verb that has no method of its own, such as
+// Swift's COPY, are issued with it.
+func (client *ServiceClient) Request(method, url string, opts *RequestOpts) (*http.Response, error) {
+	if opts == nil {
+		opts = &RequestOpts{}
+	}
 	if opts.MoreHeaders == nil {
 		opts.MoreHeaders = make(map[string]string)
 	}
 	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	opts.serviceType = client.Type
 
-	return client.Request("DELETE", url, opts)
+	return client.ProviderClient.Request(method, url, opts)
 }
@@ -0,0 +1,99 @@
+package gophercloud
+
+// ResourceState is the service-independent state of a resource, derived from
+// the status string reported by its service.
+type ResourceState int
+
+const (
+	// StatePending means the resource is transitioning between states, e.g.
+	// while it is being built, resized or deleted.
+	StatePending ResourceState = iota
+	// StateReady means the resource is in a stable, usable state.
+	StateReady
+	// StateDeleted means the resource has been deleted.
+	StateDeleted
+	// StateFailed means the resource is in an error state. A failed resource
+	// may still be deleted.
+	StateFailed
+	// StateDeleteFailed means the service failed to delete the resource.
+	StateDeleteFailed
+)
+
+func (s ResourceState) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+	case StateReady:
+		return "ready"
+	case StateDeleted:
+		return "deleted"
+	case StateFailed:
+		return "failed"
+	case StateDeleteFailed:
+		return "delete failed"
+	}
+	return "unknown"
+}
+
+// StatefulResource is implemented by resources whose status can be mapped to a
+// ResourceState.
+type StatefulResource interface {
+	// State returns the ResourceState of the resource, along with the status
+	// string it was derived from.
+	State() (ResourceState, string)
+}
+
+// StateFunc retrieves the current state of a resource, usually by calling the
+// Get function of its package and extracting the result.
+type StateFunc func() (StatefulResource, error)
+
+// WaitUntilReady polls get, once per second, until the resource is ready or
+// the timeout (in seconds) is exceeded. It returns an ErrResourceFailed if the
+// resource fails, and an ErrResourceDeleted if it disappears.
+func WaitUntilReady(timeout int, get StateFunc) error {
+	return WaitFor(timeout, func() (bool, error) {
+		r, err := get()
+		if err != nil {
+			if _, ok := err.(ErrDefault404); ok {
+				return false, ErrResourceDeleted{}
+			}
+			return false, err
+		}
+
+		switch state, status := r.State(); state {
+		case StateReady:
+			return true, nil
+		case StateFailed, StateDeleteFailed:
+			return false, ErrResourceFailed{Status: status}
+		case StateDeleted:
+			return false, ErrResourceDeleted{Status: status}
+		}
+		return false, nil
+	})
+}
+
+// WaitUntilDeleted polls get, once per second, until the resource is deleted
+// or the timeout (in seconds) is exceeded. A resource that can no longer be
+// found is considered deleted. Services keep reporting the error status of a
+// failed resource while deleting it, so StateFailed is treated as pending;
+// WaitUntilDeleted only returns an ErrResourceFailed if the deletion itself
+// fails.
+func WaitUntilDeleted(timeout int, get StateFunc) error {
+	return WaitFor(timeout, func() (bool, error) {
+		r, err := get()
+		if err != nil {
+			if _, ok := err.(ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+
+		switch state, status := r.State(); state {
+		case StateDeleted:
+			return true, nil
+		case StateDeleteFailed:
+			return false, ErrResourceFailed{Status: status}
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,178 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestAuditor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Openstack-Request-Id", "req-1234")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	var buf bytes.Buffer
+	provider := &gophercloud.ProviderClient{
+		Auditor: &gophercloud.Auditor{
+			Sink: gophercloud.NewWriterAuditSink(&buf),
+			Identity: func() (string, string) {
+				return "user-id", "project-id"
+			},
+		},
+	}
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       th.Endpoint(),
+		Type:           "compute",
+	}
+
+	body := map[string]interface{}{
+		"server": map[string]interface{}{
+			"name":      "test",
+			"adminPass": "hunter2",
+			"metadata":  map[string]string{"description": strings.Repeat("x", 300)},
+		},
+	}
+	_, err := client.Post(client.ServiceURL("servers"), body, nil, nil)
+	th.AssertNoErr(t, err)
+
+	// Reads are not audited.
+	_, err = client.Get(client.ServiceURL("servers"), nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	th.AssertNoErr(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	th.AssertEquals(t, 1, len(lines))
+
+	var record map[string]interface{}
+	err = json.Unmarshal([]byte(lines[0]), &record)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "user-id", record["user_id"])
+	th.CheckEquals(t, "project-id", record["project_id"])
+	th.CheckEquals(t, "compute", record["service_type"])
+	th.CheckEquals(t, "POST", record["method"])
+	th.CheckEquals(t, th.Endpoint()+"servers", record["url"])
+	th.CheckEquals(t, float64(202), record["status"])
+	th.CheckEquals(t, "req-1234", record["request_id"])
+
+	server := record["body"].(map[string]interface{})["server"].(map[string]interface{})
+	th.CheckEquals(t, "test", server["name"])
+	th.CheckEquals(t, "***", server["adminPass"])
+	description := server["metadata"].(map[string]interface{})["description"].(string)
+	th.CheckEquals(t, 259, len(description))
+}
+
+func TestAuditorAuthIdentity(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	var buf bytes.Buffer
+	provider := &gophercloud.ProviderClient{
+		AuthIdentity: &gophercloud.AuthIdentity{
+			UserID:    "user-id",
+			ProjectID: "project-id",
+		},
+		Auditor: &gophercloud.Auditor{
+			Sink: gophercloud.NewWriterAuditSink(&buf),
+		},
+	}
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       th.Endpoint(),
+	}
+
+	_, err := client.Delete(client.ServiceURL("servers"), nil)
+	th.AssertNoErr(t, err)
+
+	var record gophercloud.AuditRecord
+	err = json.Unmarshal(buf.Bytes(), &record)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "user-id", record.UserID)
+	th.CheckEquals(t, "project-id", record.ProjectID)
+}
+
+func TestAuditorRedactsCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	th.Mux.HandleFunc("/ec2tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	var buf bytes.Buffer
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			Auditor: &gophercloud.Auditor{
+				Sink: gophercloud.NewWriterAuditSink(&buf),
+			},
+		},
+		Endpoint: th.Endpoint(),
+	}
+
+	totp := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"totp"},
+				"totp": map[string]interface{}{
+					"user": map[string]interface{}{"id": "ee4dfb", "passcode": "123456"},
+				},
+			},
+		},
+	}
+	_, err := client.Post(client.ServiceURL("auth", "tokens"), totp, nil, nil)
+	th.AssertNoErr(t, err)
+
+	ec2 := map[string]interface{}{
+		"credentials": map[string]interface{}{
+			"access":    "a7f1e798b7c2417cba4a02de97dc3cdc",
+			"signature": "Nq51+1JvoQu3Q0jkZLHPe/t/2RI=",
+		},
+	}
+	_, err = client.Post(client.ServiceURL("ec2tokens"), ec2, nil, nil)
+	th.AssertNoErr(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	th.AssertEquals(t, 2, len(lines))
+	th.CheckEquals(t, false, strings.Contains(lines[0], "123456"))
+	th.CheckEquals(t, true, strings.Contains(lines[0], `"passcode":"***"`))
+	th.CheckEquals(t, false, strings.Contains(lines[1], "Nq51"))
+	th.CheckEquals(t, true, strings.Contains(lines[1], `"signature":"***"`))
+	th.CheckEquals(t, true, strings.Contains(lines[1], "a7f1e798b7c2417cba4a02de97dc3cdc"))
+}
+
+func TestFileAuditSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gophercloud-audit")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.jsonl")
+	sink, err := gophercloud.NewFileAuditSink(path)
+	th.AssertNoErr(t, err)
+
+	err = sink.WriteAuditRecord(&gophercloud.AuditRecord{Method: "DELETE", URL: "http://example.com/ports/1", StatusCode: 204})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, sink.Close())
+
+	b, err := ioutil.ReadFile(path)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, `{"timestamp":"0001-01-01T00:00:00Z","method":"DELETE","url":"http://example.com/ports/1","status":204}`+"\n", string(b))
+}
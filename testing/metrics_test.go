@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+type fakeReporter struct {
+	metrics []gophercloud.RequestMetrics
+}
+
+func (r *fakeReporter) ReportRequest(m gophercloud.RequestMetrics) {
+	r.metrics = append(r.metrics, m)
+}
+
+func TestURLTemplate(t *testing.T) {
+	cases := []struct {
+		serviceType, url, expected string
+	}{
+		{"compute", "http://openstack.example.com:8774/v2.1/2a5d3b3e5e4a4b7c9d2e4a1f9b6c7d8e/servers/detail", "/v2.1/{id}/servers/detail"},
+		{"compute", "http://openstack.example.com/compute/v2.1/os-keypairs/my-key", "/compute/v2.1/os-keypairs/{id}"},
+		{"compute", "http://openstack.example.com/v2.1/flavors/m1.tiny/os-extra_specs/hw:cpu_policy", "/v2.1/flavors/{id}/os-extra_specs/{id}"},
+		{"network", "http://openstack.example.com/v2.0/ports/9b4e1e5c-7b02-4b21-a6e3-5f0d6e6cd1d2?fields=id", "/v2.0/ports/{id}"},
+		{"network", "http://openstack.example.com/v2.0/qos/policies/web/bandwidth_limit_rules", "/v2.0/qos/policies/{id}/bandwidth_limit_rules"},
+		{"orchestration", "http://openstack.example.com/v1/stacks/mystack/42/resources/my_server", "/v1/stacks/{id}/{id}/resources/{id}"},
+		{"identity", "http://openstack.example.com/v3/OS-FEDERATION/identity_providers/myidp/protocols/saml2/auth", "/v3/OS-FEDERATION/identity_providers/{id}/protocols/{id}/auth"},
+		{"identity", "/v3/auth/tokens", "/v3/auth/tokens"},
+		{"object-store", "http://openstack.example.com/v1/AUTH_0123456789abcdef", "/v1/{id}"},
+		{"object-store", "http://openstack.example.com/v1/AUTH_0123456789abcdef/container", "/v1/{id}/{id}"},
+		{"object-store", "http://openstack.example.com/swift/v1/AUTH_0123456789abcdef/container/a/b/c.txt", "/swift/v1/{id}/{id}/{id}"},
+		{"", "http://openstack.example.com/v1/AUTH_0123456789abcdef/container", "/v1/{id}/container"},
+		{"", "http://openstack.example.com", "/"},
+	}
+	for _, c := range cases {
+		th.CheckEquals(t, c.expected, gophercloud.URLTemplate(c.serviceType, c.url))
+	}
+}
+
+func TestRequestMetrics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{}`)
+	})
+
+	reporter := new(fakeReporter)
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{Metrics: reporter},
+		Endpoint:       th.Endpoint(),
+		Type:           "compute",
+	}
+
+	_, err := client.Get(client.ServiceURL("servers", "1234"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(reporter.metrics))
+	m := reporter.metrics[0]
+	th.CheckEquals(t, "compute", m.ServiceType)
+	th.CheckEquals(t, "GET", m.Method)
+	th.CheckEquals(t, "/servers/{id}", m.URLTemplate)
+	th.CheckEquals(t, 200, m.StatusCode)
+	th.CheckEquals(t, 0, m.Retries)
+	th.CheckEquals(t, false, m.Reauthenticated)
+	th.CheckEquals(t, nil, m.Err)
+}
+
+func TestRequestMetricsProviderClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	reporter := new(fakeReporter)
+	provider := &gophercloud.ProviderClient{Metrics: reporter}
+
+	_, err := provider.Request("GET", th.Endpoint()+"servers/1234", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(reporter.metrics))
+	th.CheckEquals(t, "", reporter.metrics[0].ServiceType)
+	th.CheckEquals(t, "/servers/{id}", reporter.metrics[0].URLTemplate)
+}
+
+func TestRequestMetricsReauth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	reporter := new(fakeReporter)
+	provider := &gophercloud.ProviderClient{TokenID: "old-token", Metrics: reporter}
+	provider.ReauthFunc = func() error {
+		provider.TokenID = "new-token"
+		return nil
+	}
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       th.Endpoint(),
+		Type:           "compute",
+	}
+
+	_, err := client.Get(client.ServiceURL("servers"), nil, &gophercloud.RequestOpts{OkCodes: []int{204}})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(reporter.metrics))
+	m := reporter.metrics[0]
+	th.CheckEquals(t, 204, m.StatusCode)
+	th.CheckEquals(t, 1, m.Retries)
+	th.CheckEquals(t, true, m.Reauthenticated)
+}
@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Trace-Id", "abc")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"name": "server"}`)
+	})
+
+	var calls []string
+	trace := func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+		return func(method, url string, options *gophercloud.RequestOpts) (*http.Response, error) {
+			calls = append(calls, "trace:"+options.ServiceType())
+			options.MoreHeaders["X-Trace-Id"] = "abc"
+			return next(method, url, options)
+		}
+	}
+	audit := func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+		return func(method, url string, options *gophercloud.RequestOpts) (*http.Response, error) {
+			calls = append(calls, "audit:"+method)
+			resp, err := next(method, url, options)
+			result := options.JSONResponse.(*map[string]string)
+			calls = append(calls, "audit:"+(*result)["name"])
+			return resp, err
+		}
+	}
+
+	provider := &gophercloud.ProviderClient{}
+	provider.Use(trace, audit)
+	client := &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       th.Endpoint(),
+		Type:           "compute",
+	}
+
+	var result map[string]string
+	_, err := client.Get(client.ServiceURL("servers"), &result, nil)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"trace:compute", "audit:GET", "audit:server"}, calls)
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	injected := errors.New("injected fault")
+	provider := &gophercloud.ProviderClient{}
+	provider.Use(func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+		return func(method, url string, options *gophercloud.RequestOpts) (*http.Response, error) {
+			return nil, injected
+		}
+	})
+
+	_, err := provider.Request("GET", "http://unreachable.invalid/", &gophercloud.RequestOpts{})
+	th.CheckEquals(t, injected, err)
+}
+
+func TestMiddlewareReauth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var statuses []int
+	provider := &gophercloud.ProviderClient{TokenID: "old-token"}
+	provider.ReauthFunc = func() error {
+		provider.TokenID = "new-token"
+		return nil
+	}
+	provider.Use(func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+		return func(method, url string, options *gophercloud.RequestOpts) (*http.Response, error) {
+			resp, err := next(method, url, options)
+			statuses = append(statuses, resp.StatusCode)
+			return resp, err
+		}
+	})
+
+	_, err := provider.Request("GET", th.Endpoint()+"servers", &gophercloud.RequestOpts{OkCodes: []int{204}})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []int{401, 204}, statuses)
+}
@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -248,3 +249,35 @@ func TestBuildRequestBody(t *testing.T) {
 		th.AssertDeepEquals(t, reflect.TypeOf(failCase.expected), reflect.TypeOf(err))
 	}
 }
+
+func TestBuildRequestBodySkipsUnserializedFields(t *testing.T) {
+	type credentials struct {
+		ID string `json:"id" required:"true"`
+	}
+
+	type opts struct {
+		Name string `json:"name"`
+
+		// ExpiresAt is formatted by the caller, and would not be a valid
+		// nested request body.
+		ExpiresAt *time.Time `json:"-"`
+
+		// Internal is not sent, so its required fields are not checked.
+		Internal *credentials `json:"-"`
+	}
+
+	expiresAt := time.Date(2016, 10, 1, 10, 0, 0, 0, time.UTC)
+	actual, err := gophercloud.BuildRequestBody(opts{
+		Name:      "monitoring",
+		ExpiresAt: &expiresAt,
+		Internal:  &credentials{},
+	}, "application_credential")
+	th.AssertNoErr(t, err)
+
+	expected := map[string]interface{}{
+		"application_credential": map[string]interface{}{
+			"name": "monitoring",
+		},
+	}
+	th.AssertDeepEquals(t, expected, actual)
+}
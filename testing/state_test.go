@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+type fakeResource string
+
+func (r fakeResource) State() (gophercloud.ResourceState, string) {
+	switch r {
+	case "up":
+		return gophercloud.StateReady, string(r)
+	case "gone":
+		return gophercloud.StateDeleted, string(r)
+	case "broken":
+		return gophercloud.StateFailed, string(r)
+	case "undeletable":
+		return gophercloud.StateDeleteFailed, string(r)
+	}
+	return gophercloud.StatePending, string(r)
+}
+
+func statesOf(states ...string) gophercloud.StateFunc {
+	return func() (gophercloud.StatefulResource, error) {
+		s := states[0]
+		if len(states) > 1 {
+			states = states[1:]
+		}
+		if s == "404" {
+			return nil, gophercloud.ErrDefault404{}
+		}
+		return fakeResource(s), nil
+	}
+}
+
+func TestWaitUntilReady(t *testing.T) {
+	err := gophercloud.WaitUntilReady(5, statesOf("building", "up"))
+	th.AssertNoErr(t, err)
+
+	err = gophercloud.WaitUntilReady(5, statesOf("broken"))
+	th.AssertEquals(t, gophercloud.ErrResourceFailed{Status: "broken"}, err)
+
+	err = gophercloud.WaitUntilReady(5, statesOf("404"))
+	th.AssertEquals(t, gophercloud.ErrResourceDeleted{}, err)
+}
+
+func TestWaitUntilDeleted(t *testing.T) {
+	err := gophercloud.WaitUntilDeleted(5, statesOf("deleting", "gone"))
+	th.AssertNoErr(t, err)
+
+	err = gophercloud.WaitUntilDeleted(5, statesOf("404"))
+	th.AssertNoErr(t, err)
+
+	err = gophercloud.WaitUntilDeleted(5, statesOf("deleting", "undeletable"))
+	th.AssertEquals(t, gophercloud.ErrResourceFailed{Status: "undeletable"}, err)
+}
+
+func TestWaitUntilDeletedFailedResource(t *testing.T) {
+	err := gophercloud.WaitUntilDeleted(5, statesOf("broken", "broken", "404"))
+	th.AssertNoErr(t, err)
+
+	err = gophercloud.WaitUntilDeleted(5, statesOf("broken", "undeletable"))
+	th.AssertEquals(t, gophercloud.ErrResourceFailed{Status: "undeletable"}, err)
+}